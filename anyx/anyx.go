@@ -0,0 +1,84 @@
+package anyx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrTypeMismatch is wrapped by the error of a Failure returned when a value exists
+// but does not have the requested type.
+var ErrTypeMismatch = errors.New("type mismatch")
+
+// Get extracts a typed value from a loosely typed payload such as decoded JSON.
+// The path is a dot-separated list of map keys; numeric segments index into []any.
+//
+// Behavior:
+//   - If the value exists and has type T: returns Just(value)
+//   - If a key or index along the path is missing, or the value is nil: returns None
+//   - If the value, or an intermediate value, has an unexpected type: returns Failure wrapping ErrTypeMismatch
+//   - If a segment indexing into []any is not a number: returns Failure wrapping ErrTypeMismatch
+//
+// encoding/json decodes every number into an any as float64, so on an unmarshalled payload
+// Get[int] always fails with ErrTypeMismatch; use Get[float64], or decode with
+// json.Decoder.UseNumber and Get[json.Number].
+//
+// Example:
+//
+//	payload := map[string]any{
+//	    "user": map[string]any{
+//	        "name":   "alice",
+//	        "emails": []any{"a@example.com"},
+//	    },
+//	}
+//	anyx.Get[string](payload, "user.name")       // Just("alice")
+//	anyx.Get[string](payload, "user.emails.0")   // Just("a@example.com")
+//	anyx.Get[string](payload, "user.phone")      // Empty[string]()
+//	anyx.Get[int](payload, "user.name")          // Failed[int](type mismatch)
+func Get[T any](m map[string]any, path string) maybe.Maybe[T] {
+	var current any = m
+	var visited []string
+
+	for _, segment := range strings.Split(path, ".") {
+		visited = append(visited, segment)
+		switch node := current.(type) {
+		case map[string]any:
+			v, ok := node[segment]
+			if !ok {
+				return maybe.Empty[T]()
+			}
+			current = v
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil {
+				return maybe.Failed[T](fmt.Errorf("%w at %q: want a numeric index into []any, got %q",
+					ErrTypeMismatch, strings.Join(visited[:len(visited)-1], "."), segment))
+			}
+			if i < 0 || i >= len(node) {
+				return maybe.Empty[T]()
+			}
+			current = node[i]
+		case nil:
+			return maybe.Empty[T]()
+		default:
+			return mismatch[T](visited[:len(visited)-1], "map[string]any or []any", node)
+		}
+	}
+
+	if current == nil {
+		return maybe.Empty[T]()
+	}
+	v, ok := current.(T)
+	if !ok {
+		return mismatch[T](visited, reflect.TypeFor[T]().String(), current)
+	}
+	return maybe.Just(v)
+}
+
+func mismatch[T any](path []string, want string, got any) maybe.Maybe[T] {
+	return maybe.Failed[T](fmt.Errorf("%w at %q: want %s, got %T", ErrTypeMismatch, strings.Join(path, "."), want, got))
+}
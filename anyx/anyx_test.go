@@ -0,0 +1,114 @@
+package anyx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/anyx"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func payload(t *testing.T) map[string]any {
+	t.Helper()
+	var m map[string]any
+	err := json.Unmarshal([]byte(`{
+		"event": "push",
+		"count": 3,
+		"deleted": null,
+		"repo": {
+			"name": "lw-project-fp-go",
+			"owner": {"login": "lonelywolflee"},
+			"topics": ["go", "fp"],
+			"commits": [{"id": "abc"}]
+		}
+	}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestGet(t *testing.T) {
+	m := payload(t)
+
+	t.Run("returns top-level value", func(t *testing.T) {
+		if v, ok, _ := anyx.Get[string](m, "event").Get(); !ok || v != "push" {
+			t.Errorf("expected Just(push), got %q", v)
+		}
+	})
+
+	t.Run("follows dotted paths", func(t *testing.T) {
+		if v, _, _ := anyx.Get[string](m, "repo.owner.login").Get(); v != "lonelywolflee" {
+			t.Errorf("expected lonelywolflee, got %q", v)
+		}
+	})
+
+	t.Run("indexes into slices", func(t *testing.T) {
+		if v, _, _ := anyx.Get[string](m, "repo.topics.1").Get(); v != "fp" {
+			t.Errorf("expected fp, got %q", v)
+		}
+		if v, _, _ := anyx.Get[string](m, "repo.commits.0.id").Get(); v != "abc" {
+			t.Errorf("expected abc, got %q", v)
+		}
+	})
+
+	t.Run("returns nested maps and slices", func(t *testing.T) {
+		if _, ok := anyx.Get[map[string]any](m, "repo.owner").(maybe.Some[map[string]any]); !ok {
+			t.Error("expected Some for nested map")
+		}
+		if v, _, _ := anyx.Get[[]any](m, "repo.topics").Get(); len(v) != 2 {
+			t.Errorf("expected 2 topics, got %v", v)
+		}
+	})
+
+	t.Run("returns None for missing keys, indexes and nulls", func(t *testing.T) {
+		paths := []string{"missing", "repo.missing.deeper", "repo.topics.5", "repo.topics.-1", "deleted", "deleted.child"}
+		for _, path := range paths {
+			if _, ok := anyx.Get[string](m, path).(maybe.None[string]); !ok {
+				t.Errorf("expected None for %q", path)
+			}
+		}
+	})
+
+	t.Run("returns Failure for leaf type mismatch", func(t *testing.T) {
+		_, _, err := anyx.Get[int](m, "count").Get()
+		if !errors.Is(err, anyx.ErrTypeMismatch) {
+			t.Fatalf("expected ErrTypeMismatch, got %v", err)
+		}
+		want := `type mismatch at "count": want int, got float64`
+		if err.Error() != want {
+			t.Errorf("expected %q, got %q", want, err.Error())
+		}
+	})
+
+	t.Run("returns Failure when traversing through a scalar", func(t *testing.T) {
+		_, _, err := anyx.Get[string](m, "event.name").Get()
+		if !errors.Is(err, anyx.ErrTypeMismatch) {
+			t.Errorf("expected ErrTypeMismatch, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure for non-numeric slice segment", func(t *testing.T) {
+		_, _, err := anyx.Get[string](m, "repo.topics.first").Get()
+		if !errors.Is(err, anyx.ErrTypeMismatch) || !strings.Contains(err.Error(), `want a numeric index into []any, got "first"`) {
+			t.Errorf("expected ErrTypeMismatch about the index, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure for int on a JSON number", func(t *testing.T) {
+		if _, _, err := anyx.Get[int](m, "count").Get(); !errors.Is(err, anyx.ErrTypeMismatch) {
+			t.Errorf("expected ErrTypeMismatch for a float64 number, got %v", err)
+		}
+		if v, _, _ := anyx.Get[float64](m, "count").Get(); v != 3 {
+			t.Errorf("expected Just(3.0), got %v", v)
+		}
+	})
+
+	t.Run("works with any as target type", func(t *testing.T) {
+		if v, ok, _ := anyx.Get[any](m, "count").Get(); !ok || v != float64(3) {
+			t.Errorf("expected Just(3), got %v", v)
+		}
+	})
+}
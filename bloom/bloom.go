@@ -0,0 +1,116 @@
+package bloom
+
+import (
+	"hash/maphash"
+	"math"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Filter is a probabilistic set: MaybeContains never reports false for an added value, but may
+// report true for a value that was never added, at roughly the false-positive rate the filter
+// was sized for. Memory stays fixed no matter how many values are added, which makes it suitable
+// for approximate dedup over key streams too large to hold in a map. A Filter is safe for
+// concurrent use.
+//
+// Example:
+//
+//	seen := bloom.New[string](1_000_000, 0.001)
+//
+//	for _, id := range ids {
+//	    if !seen.MaybeContains(id) {
+//	        process(id)
+//	        seen.Add(id)
+//	    }
+//	}
+type Filter[T comparable] struct {
+	mu    sync.Mutex
+	bits  []uint64
+	m     uint64
+	k     int
+	seed1 maphash.Seed
+	seed2 maphash.Seed
+}
+
+// New creates a Filter sized for expected values at the given false-positive rate.
+// A non-positive expected is treated as 1, and a rate outside (0, 1) is treated as 0.01.
+func New[T comparable](expected int, falsePositiveRate float64) *Filter[T] {
+	n := float64(max(expected, 1))
+	p := falsePositiveRate
+	if !(p > 0 && p < 1) {
+		p = 0.01
+	}
+	m := uint64(math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2)))
+	m = max(m, 64)
+	k := max(int(math.Round(float64(m)/n*math.Ln2)), 1)
+	return &Filter[T]{
+		bits:  make([]uint64, (m+63)/64),
+		m:     m,
+		k:     k,
+		seed1: maphash.MakeSeed(),
+		seed2: maphash.MakeSeed(),
+	}
+}
+
+// Add records v in the filter.
+func (f *Filter[T]) Add(v T) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.add(v)
+}
+
+// MaybeContains reports whether v may have been added. A false result is definite;
+// a true result is correct except at about the filter's false-positive rate.
+func (f *Filter[T]) MaybeContains(v T) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.contains(v)
+}
+
+// Dedupe returns a pipeline step that lets each value through once: values the filter may
+// already contain become None, and new values are added and returned as Some. Because the
+// filter is probabilistic, a small fraction of new values may be dropped as false positives;
+// no duplicate is ever let through. Its signature fits FlatMap directly.
+//
+// Example:
+//
+//	firstSeen := bloom.Dedupe(bloom.New[string](1_000_000, 0.001))
+//
+//	result := maybe.FlatMap(eventID, firstSeen) // None for IDs already seen
+func Dedupe[T comparable](f *Filter[T]) func(T) maybe.Maybe[T] {
+	return func(v T) maybe.Maybe[T] {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.contains(v) {
+			return maybe.Empty[T]()
+		}
+		f.add(v)
+		return maybe.Just(v)
+	}
+}
+
+func (f *Filter[T]) add(v T) {
+	h1, h2 := f.hashes(v)
+	for i := range f.k {
+		bit := (h1 + uint64(i)*h2) % f.m
+		f.bits[bit/64] |= uint64(1) << (bit % 64)
+	}
+}
+
+func (f *Filter[T]) contains(v T) bool {
+	h1, h2 := f.hashes(v)
+	for i := range f.k {
+		bit := (h1 + uint64(i)*h2) % f.m
+		if f.bits[bit/64]&(uint64(1)<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hashes returns the two base hashes for double hashing. h2 is forced odd so that successive
+// probes do not collapse onto the same bit.
+func (f *Filter[T]) hashes(v T) (uint64, uint64) {
+	return maphash.Comparable(f.seed1, v), maphash.Comparable(f.seed2, v) | 1
+}
@@ -0,0 +1,88 @@
+package bloom_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/bloom"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestFilter(t *testing.T) {
+	t.Run("reports added values as present", func(t *testing.T) {
+		f := bloom.New[string](1000, 0.01)
+		for i := range 1000 {
+			f.Add("key-" + strconv.Itoa(i))
+		}
+		for i := range 1000 {
+			if !f.MaybeContains("key-" + strconv.Itoa(i)) {
+				t.Fatalf("key-%d: false negative", i)
+			}
+		}
+	})
+
+	t.Run("keeps false positives near the target rate", func(t *testing.T) {
+		f := bloom.New[int](10000, 0.01)
+		for i := range 10000 {
+			f.Add(i)
+		}
+		falsePositives := 0
+		for i := 10000; i < 20000; i++ {
+			if f.MaybeContains(i) {
+				falsePositives++
+			}
+		}
+		if falsePositives > 300 {
+			t.Errorf("expected about 100 false positives, got %d", falsePositives)
+		}
+	})
+
+	t.Run("empty filter contains nothing", func(t *testing.T) {
+		f := bloom.New[int](0, 2)
+		if f.MaybeContains(1) {
+			t.Error("expected empty filter to report absent")
+		}
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		f := bloom.New[int](1000, 0.01)
+		var wg sync.WaitGroup
+		for w := range 4 {
+			wg.Go(func() {
+				for i := range 250 {
+					f.Add(w*250 + i)
+				}
+			})
+		}
+		wg.Wait()
+		for i := range 1000 {
+			if !f.MaybeContains(i) {
+				t.Fatalf("%d: false negative", i)
+			}
+		}
+	})
+}
+
+func TestDedupe(t *testing.T) {
+	t.Run("lets each value through once", func(t *testing.T) {
+		firstSeen := bloom.Dedupe(bloom.New[string](100, 0.001))
+		var passed []string
+		for _, id := range []string{"a", "b", "a", "c", "b"} {
+			maybe.FlatMap(maybe.Just(id), firstSeen).Then(func(v string) {
+				passed = append(passed, v)
+			})
+		}
+		if len(passed) != 3 || passed[0] != "a" || passed[1] != "b" || passed[2] != "c" {
+			t.Errorf("expected [a b c], got %v", passed)
+		}
+	})
+
+	t.Run("returns None for already added values", func(t *testing.T) {
+		f := bloom.New[int](10, 0.01)
+		f.Add(7)
+		if _, ok := bloom.Dedupe(f)(7).(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrExhausted is wrapped by every *ExhaustedError, so callers can test for it with errors.Is.
+var ErrExhausted = errors.New("budget exhausted")
+
+// StepTiming records how long one step took.
+type StepTiming struct {
+	Name     string
+	Duration time.Duration
+}
+
+// ExhaustedError describes where a chain ran out of time and what the time was spent on.
+type ExhaustedError struct {
+	// Step is the step that was refused or that overran the budget.
+	Step string
+	// Total is the budget the chain started with.
+	Total time.Duration
+	// Elapsed is the time spent since the budget was created.
+	Elapsed time.Duration
+	// Steps lists the steps that ran, in order, with their durations.
+	Steps []StepTiming
+}
+
+// Error returns a message naming the step, the budget, and the per-step breakdown.
+func (e *ExhaustedError) Error() string {
+	parts := make([]string, len(e.Steps))
+	for i, s := range e.Steps {
+		parts[i] = fmt.Sprintf("%s=%s", s.Name, s.Duration)
+	}
+	return fmt.Sprintf("%v: %s budget used up after %s at step %q [%s]",
+		ErrExhausted, e.Total, e.Elapsed, e.Step, strings.Join(parts, " "))
+}
+
+// Unwrap returns ErrExhausted.
+func (e *ExhaustedError) Unwrap() error {
+	return ErrExhausted
+}
+
+// Budget is a latency allowance shared by the steps of one chain, such as one request
+// fanning out to several backends. It is safe for concurrent use.
+//
+// Example:
+//
+//	ctx = budget.WithBudget(ctx, budget.New(300*time.Millisecond))
+//
+//	user := budget.Step(ctx, "users", fetchUser)
+//	orders := maybe.FlatMap(user, func(u User) maybe.Maybe[[]Order] {
+//	    return budget.Step(ctx, "orders", func(ctx context.Context) ([]Order, error) {
+//	        return ordersAPI.List(ctx, u.ID)
+//	    })
+//	})
+//	// Failure wrapping ErrExhausted if the 300ms ran out before or during "orders"
+type Budget struct {
+	total time.Duration
+	start time.Time
+	clock clock.Clock
+
+	mu    sync.Mutex
+	steps []StepTiming
+}
+
+type contextKey struct{}
+
+// New creates a Budget of total, starting now.
+func New(total time.Duration) *Budget {
+	return NewWithClock(total, clock.System)
+}
+
+// NewWithClock creates a Budget of total measured with c; nil uses clock.System.
+func NewWithClock(total time.Duration, c clock.Clock) *Budget {
+	c = clock.OrSystem(c)
+	return &Budget{total: total, start: c.Now(), clock: c}
+}
+
+// WithBudget returns a copy of ctx carrying b, for Step to find.
+func WithBudget(ctx context.Context, b *Budget) context.Context {
+	return context.WithValue(ctx, contextKey{}, b)
+}
+
+// FromContext returns the Budget carried by ctx.
+//
+// Behavior:
+//   - If ctx carries a Budget: returns Just(budget)
+//   - Otherwise: returns None
+func FromContext(ctx context.Context) maybe.Maybe[*Budget] {
+	if b, ok := ctx.Value(contextKey{}).(*Budget); ok {
+		return maybe.Just(b)
+	}
+	return maybe.Empty[*Budget]()
+}
+
+// Remaining returns how much of the budget is left; it is negative once overrun.
+func (b *Budget) Remaining() time.Duration {
+	return b.total - b.clock.Now().Sub(b.start)
+}
+
+// Steps returns the recorded step timings in the order the steps finished.
+func (b *Budget) Steps() []StepTiming {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]StepTiming(nil), b.steps...)
+}
+
+// exhausted builds the error reported for step.
+func (b *Budget) exhausted(step string) *ExhaustedError {
+	return &ExhaustedError{
+		Step:    step,
+		Total:   b.total,
+		Elapsed: b.clock.Now().Sub(b.start),
+		Steps:   b.Steps(),
+	}
+}
+
+// Step runs fn as the named step of the chain whose Budget is carried by ctx,
+// and records how long it took. fn receives a context whose deadline is the end of the
+// budget, so a step that blocks on it is cut off when the budget runs out.
+// Without a Budget in ctx, Step simply runs fn.
+//
+// Behavior:
+//   - If the budget is already used up: returns Failure(*ExhaustedError) without calling fn
+//   - If fn fails because the step context hit the budget deadline: returns Failure(*ExhaustedError)
+//   - If fn returns any other error or panics: returns Failure with that error
+//   - If fn succeeds but the budget ran out while it ran, even exactly at the deadline:
+//     returns Failure(*ExhaustedError), as the next step would be refused
+//   - Otherwise: returns Just(value)
+//
+// The step deadline is a context deadline, so it follows the wall clock even when the
+// Budget is measured with a different Clock.
+//
+// Example:
+//
+//	profile := budget.Step(ctx, "profile", func(ctx context.Context) (Profile, error) {
+//	    return profiles.Get(ctx, id)
+//	})
+func Step[T any](ctx context.Context, name string, fn func(ctx context.Context) (T, error)) maybe.Maybe[T] {
+	b, ok, _ := FromContext(ctx).Get()
+	if !ok {
+		return maybe.Try(func() (T, error) { return fn(ctx) })
+	}
+	if b.Remaining() <= 0 {
+		return maybe.Failed[T](b.exhausted(name))
+	}
+
+	stepCtx, cancel := context.WithTimeout(ctx, b.Remaining())
+	defer cancel()
+
+	started := b.clock.Now()
+	result := maybe.Try(func() (T, error) { return fn(stepCtx) })
+
+	b.mu.Lock()
+	b.steps = append(b.steps, StepTiming{Name: name, Duration: b.clock.Now().Sub(started)})
+	b.mu.Unlock()
+
+	_, _, err := result.Get()
+	cutOff := err != nil && ctx.Err() == nil && errors.Is(stepCtx.Err(), context.DeadlineExceeded)
+	if cutOff || (err == nil && b.Remaining() <= 0) {
+		return maybe.Failed[T](b.exhausted(name))
+	}
+	return result
+}
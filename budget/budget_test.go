@@ -0,0 +1,180 @@
+package budget_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/budget"
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// taking returns a step function that advances fake by d and then returns v.
+func taking(fake *clock.Fake, d time.Duration, v int) func(context.Context) (int, error) {
+	return func(context.Context) (int, error) {
+		fake.Advance(d)
+		return v, nil
+	}
+}
+
+func setup(total time.Duration) (context.Context, *budget.Budget, *clock.Fake) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	b := budget.NewWithClock(total, fake)
+	return budget.WithBudget(context.Background(), b), b, fake
+}
+
+func TestStep(t *testing.T) {
+	t.Run("runs steps within budget and records timings", func(t *testing.T) {
+		ctx, b, fake := setup(100 * time.Millisecond)
+
+		first := budget.Step(ctx, "users", taking(fake, 30*time.Millisecond, 1))
+		second := budget.Step(ctx, "orders", taking(fake, 40*time.Millisecond, 2))
+
+		if v, _, _ := first.Get(); v != 1 {
+			t.Errorf("expected 1, got %d", v)
+		}
+		if v, _, _ := second.Get(); v != 2 {
+			t.Errorf("expected 2, got %d", v)
+		}
+		steps := b.Steps()
+		if len(steps) != 2 || steps[0] != (budget.StepTiming{Name: "users", Duration: 30 * time.Millisecond}) || steps[1].Name != "orders" {
+			t.Errorf("unexpected step timings %+v", steps)
+		}
+		if b.Remaining() != 30*time.Millisecond {
+			t.Errorf("expected 30ms remaining, got %s", b.Remaining())
+		}
+	})
+
+	t.Run("fails a step that overruns the budget", func(t *testing.T) {
+		ctx, _, fake := setup(50 * time.Millisecond)
+
+		_, _, err := budget.Step(ctx, "slow", taking(fake, 60*time.Millisecond, 1)).Get()
+		var exhausted *budget.ExhaustedError
+		if !errors.As(err, &exhausted) || !errors.Is(err, budget.ErrExhausted) {
+			t.Fatalf("expected ExhaustedError, got %v", err)
+		}
+		if exhausted.Step != "slow" || exhausted.Elapsed != 60*time.Millisecond || len(exhausted.Steps) != 1 {
+			t.Errorf("unexpected error details %+v", exhausted)
+		}
+	})
+
+	t.Run("fails a step that ends exactly at the deadline", func(t *testing.T) {
+		ctx, _, fake := setup(50 * time.Millisecond)
+
+		_, _, err := budget.Step(ctx, "exact", taking(fake, 50*time.Millisecond, 1)).Get()
+		if !errors.Is(err, budget.ErrExhausted) {
+			t.Errorf("expected ErrExhausted at zero remaining, got %v", err)
+		}
+	})
+
+	t.Run("refuses steps once the budget is used up", func(t *testing.T) {
+		ctx, _, fake := setup(50 * time.Millisecond)
+		fake.Advance(50 * time.Millisecond)
+
+		_, _, err := budget.Step(ctx, "late", func(context.Context) (int, error) {
+			t.Error("step should not run")
+			return 0, nil
+		}).Get()
+		if !errors.Is(err, budget.ErrExhausted) {
+			t.Errorf("expected ErrExhausted, got %v", err)
+		}
+	})
+
+	t.Run("keeps step errors even when overrunning", func(t *testing.T) {
+		ctx, _, fake := setup(10 * time.Millisecond)
+		stepErr := errors.New("backend down")
+
+		_, _, err := budget.Step(ctx, "fails", func(context.Context) (int, error) {
+			fake.Advance(20 * time.Millisecond)
+			return 0, stepErr
+		}).Get()
+		if !errors.Is(err, stepErr) {
+			t.Errorf("expected step error, got %v", err)
+		}
+	})
+
+	t.Run("cuts off a blocking step at the budget deadline", func(t *testing.T) {
+		ctx := budget.WithBudget(context.Background(), budget.New(20*time.Millisecond))
+		started := time.Now()
+
+		_, _, err := budget.Step(ctx, "hangs", func(ctx context.Context) (int, error) {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(5 * time.Second):
+				return 1, nil
+			}
+		}).Get()
+		var exhausted *budget.ExhaustedError
+		if !errors.As(err, &exhausted) || exhausted.Step != "hangs" {
+			t.Errorf("expected ExhaustedError for hangs, got %v", err)
+		}
+		if elapsed := time.Since(started); elapsed > time.Second {
+			t.Errorf("expected step to be cut off near 20ms, took %v", elapsed)
+		}
+	})
+
+	t.Run("keeps the caller's cancellation error", func(t *testing.T) {
+		parent, cancel := context.WithCancel(context.Background())
+		cancel()
+		ctx := budget.WithBudget(parent, budget.New(time.Minute))
+
+		_, _, err := budget.Step(ctx, "cancelled", func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}).Get()
+		if !errors.Is(err, context.Canceled) || errors.Is(err, budget.ErrExhausted) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("converts panics to Failure and records the step", func(t *testing.T) {
+		ctx, b, _ := setup(time.Second)
+
+		result := budget.Step(ctx, "boom", func(context.Context) (int, error) { panic("boom") })
+		if _, ok := result.(maybe.Failure[int]); !ok {
+			t.Error("expected Failure")
+		}
+		if len(b.Steps()) != 1 {
+			t.Errorf("expected panicking step to be recorded, got %+v", b.Steps())
+		}
+	})
+
+	t.Run("runs without a budget in context", func(t *testing.T) {
+		result := budget.Step(context.Background(), "free", func(context.Context) (string, error) { return "ok", nil })
+		if v, _, _ := result.Get(); v != "ok" {
+			t.Errorf("expected ok, got %q", v)
+		}
+	})
+}
+
+func TestExhaustedError_Error(t *testing.T) {
+	ctx, _, fake := setup(50 * time.Millisecond)
+	budget.Step(ctx, "users", taking(fake, 20*time.Millisecond, 1))
+	_, _, err := budget.Step(ctx, "orders", taking(fake, 40*time.Millisecond, 1)).Get()
+
+	want := `budget exhausted: 50ms budget used up after 60ms at step "orders" [users=20ms orders=40ms]`
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Run("returns attached budget", func(t *testing.T) {
+		b := budget.New(time.Second)
+		if got, ok, _ := budget.FromContext(budget.WithBudget(context.Background(), b)).Get(); !ok || got != b {
+			t.Error("expected attached budget")
+		}
+		if b.Remaining() <= 0 || b.Remaining() > time.Second {
+			t.Errorf("unexpected remaining %s", b.Remaining())
+		}
+	})
+
+	t.Run("returns None without a budget", func(t *testing.T) {
+		if _, ok := budget.FromContext(context.Background()).(maybe.None[*budget.Budget]); !ok {
+			t.Error("expected None")
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// LRU is a bounded least-recently-used cache of Maybe results that is safe for concurrent use.
+// Some entries and None/Failure entries have separate TTLs, so "not found" lookups can be
+// cached briefly (negative caching) while successful lookups live longer.
+//
+// Example:
+//
+//	users := cache.NewLRU[int, User](10_000, 10*time.Minute, 30*time.Second)
+//	user := users.GetOrLoad(id, func(id int) maybe.Maybe[User] {
+//	    return repo.FindUser(id) // Just(user), Empty[User]() or Failed[User](err)
+//	})
+type LRU[K comparable, V any] struct {
+	mu          sync.Mutex
+	capacity    int
+	ttl         time.Duration
+	negativeTTL time.Duration
+	items       map[K]*list.Element
+	order       *list.List
+	clock       clock.Clock
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     maybe.Maybe[V]
+	expiresAt time.Time
+}
+
+// NewLRU creates an LRU holding at most capacity entries.
+//
+// Parameters:
+//   - capacity: maximum number of entries; values below 1 are treated as 1
+//   - ttl: lifetime of Some entries; a non-positive value means they never expire
+//   - negativeTTL: lifetime of None and Failure entries; a non-positive value means they are not cached
+//
+// Example:
+//
+//	c := cache.NewLRU[string, Config](100, time.Hour, time.Minute)
+func NewLRU[K comparable, V any](capacity int, ttl, negativeTTL time.Duration) *LRU[K, V] {
+	return NewLRUWithClock[K, V](capacity, ttl, negativeTTL, clock.System)
+}
+
+// NewLRUWithClock creates an LRU like NewLRU that measures TTLs with c.
+// A nil Clock uses clock.System.
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Now())
+//	c := cache.NewLRUWithClock[string, int](100, time.Minute, time.Second, fake)
+func NewLRUWithClock[K comparable, V any](capacity int, ttl, negativeTTL time.Duration, c clock.Clock) *LRU[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRU[K, V]{
+		capacity:    capacity,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		items:       map[K]*list.Element{},
+		order:       list.New(),
+		clock:       clock.OrSystem(c),
+	}
+}
+
+// Get returns the cached result for k and whether it was found.
+// Expired entries are removed and reported as not found.
+//
+// Example:
+//
+//	if m, ok := c.Get("key"); ok {
+//	    return m // cached Just, Empty, or Failed result
+//	}
+func (c *LRU[K, V]) Get(k K) (maybe.Maybe[V], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[k]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry[K, V])
+	if !e.expiresAt.IsZero() && !c.clock.Now().Before(e.expiresAt) {
+		c.removeElement(el)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Put stores a result for k using the TTL that matches its state, evicting the least
+// recently used entry when the cache is full. A None or Failure result is not stored
+// when negative caching is disabled, and it removes any existing entry for k.
+//
+// Example:
+//
+//	c.Put("a", maybe.Just(1))            // cached for ttl
+//	c.Put("b", maybe.Empty[int]())       // cached for negativeTTL
+func (c *LRU[K, V]) Put(k K, m maybe.Maybe[V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ttl := c.ttl
+	if _, ok, _ := m.Get(); !ok {
+		if c.negativeTTL <= 0 {
+			if el, found := c.items[k]; found {
+				c.removeElement(el)
+			}
+			return
+		}
+		ttl = c.negativeTTL
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.clock.Now().Add(ttl)
+	}
+
+	if el, found := c.items[k]; found {
+		e := el.Value.(*entry[K, V])
+		e.value = m
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[k] = c.order.PushFront(&entry[K, V]{key: k, value: m, expiresAt: expiresAt})
+	if c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// GetOrLoad returns the cached result for k, or calls load and caches its result.
+// The load function runs without holding the cache lock and with panic recovery.
+//
+// Behavior:
+//   - If a live entry exists: returns it (load not called)
+//   - Otherwise: calls load, caches the result per its state, and returns it
+//   - If load panics: the resulting Failure is cached like any other Failure
+//
+// Example:
+//
+//	user := c.GetOrLoad(id, repo.FindUser)
+func (c *LRU[K, V]) GetOrLoad(k K, load func(K) maybe.Maybe[V]) maybe.Maybe[V] {
+	if m, ok := c.Get(k); ok {
+		return m
+	}
+	m := maybe.Do(func() maybe.Maybe[V] {
+		return load(k)
+	})
+	c.Put(k, m)
+	return m
+}
+
+// Remove deletes the entry for k, if any.
+func (c *LRU[K, V]) Remove(k K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[k]; ok {
+		c.removeElement(el)
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet removed.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// removeElement unlinks el from the cache. The caller must hold c.mu.
+func (c *LRU[K, V]) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
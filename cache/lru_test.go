@@ -0,0 +1,230 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/cache"
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestLRU_GetPut(t *testing.T) {
+	t.Run("returns not found for missing key", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+
+		if _, ok := c.Get("missing"); ok {
+			t.Fatal("expected miss for missing key")
+		}
+	})
+
+	t.Run("returns stored Some", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+		c.Put("a", maybe.Just(1))
+
+		m, ok := c.Get("a")
+		if !ok {
+			t.Fatal("expected hit")
+		}
+		if v, _, _ := m.Get(); v != 1 {
+			t.Errorf("expected 1, got %d", v)
+		}
+	})
+
+	t.Run("updates existing entry", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+		c.Put("a", maybe.Just(1))
+		c.Put("a", maybe.Just(2))
+
+		m, _ := c.Get("a")
+		if v, _, _ := m.Get(); v != 2 || c.Len() != 1 {
+			t.Errorf("expected single entry with 2, got %d (len %d)", v, c.Len())
+		}
+	})
+
+	t.Run("evicts least recently used entry", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+		c.Put("a", maybe.Just(1))
+		c.Put("b", maybe.Just(2))
+		c.Get("a")
+		c.Put("c", maybe.Just(3))
+
+		if _, ok := c.Get("b"); ok {
+			t.Error("b should have been evicted")
+		}
+		if _, ok := c.Get("a"); !ok {
+			t.Error("a should still be cached")
+		}
+		if c.Len() != 2 {
+			t.Errorf("expected 2 entries, got %d", c.Len())
+		}
+	})
+
+	t.Run("treats capacity below one as one", func(t *testing.T) {
+		c := cache.NewLRU[string, int](0, 0, 0)
+		c.Put("a", maybe.Just(1))
+		c.Put("b", maybe.Just(2))
+
+		if c.Len() != 1 {
+			t.Errorf("expected 1 entry, got %d", c.Len())
+		}
+	})
+
+	t.Run("does not cache negatives when negative TTL is disabled", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+		c.Put("none", maybe.Empty[int]())
+		c.Put("failed", maybe.Failed[int](errors.New("boom")))
+
+		if c.Len() != 0 {
+			t.Errorf("expected no entries, got %d", c.Len())
+		}
+	})
+
+	t.Run("negative result replaces existing entry when not cached", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+		c.Put("a", maybe.Just(1))
+		c.Put("a", maybe.Empty[int]())
+
+		if _, ok := c.Get("a"); ok {
+			t.Error("stale Some should be removed by an uncached negative result")
+		}
+	})
+
+	t.Run("caches None and Failure with negative TTL", func(t *testing.T) {
+		c := cache.NewLRU[string, int](4, 0, time.Minute)
+		c.Put("none", maybe.Empty[int]())
+		c.Put("failed", maybe.Failed[int](errors.New("boom")))
+
+		m, ok := c.Get("none")
+		if _, isNone := m.(maybe.None[int]); !ok || !isNone {
+			t.Error("expected cached None")
+		}
+		m, ok = c.Get("failed")
+		if _, isFailure := m.(maybe.Failure[int]); !ok || !isFailure {
+			t.Error("expected cached Failure")
+		}
+	})
+}
+
+func TestLRU_TTL(t *testing.T) {
+	t.Run("expires Some entries after TTL", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		c := cache.NewLRUWithClock[string, int](2, time.Minute, 0, fake)
+		c.Put("a", maybe.Just(1))
+
+		fake.Advance(59 * time.Second)
+		if _, ok := c.Get("a"); !ok {
+			t.Fatal("expected hit before TTL")
+		}
+		fake.Advance(time.Second)
+		if _, ok := c.Get("a"); ok {
+			t.Error("expected miss after TTL")
+		}
+		if c.Len() != 0 {
+			t.Errorf("expired entry should be removed, len %d", c.Len())
+		}
+	})
+
+	t.Run("nil clock falls back to the system clock", func(t *testing.T) {
+		c := cache.NewLRUWithClock[string, int](2, time.Millisecond, 0, nil)
+		c.Put("a", maybe.Just(1))
+
+		time.Sleep(5 * time.Millisecond)
+		if _, ok := c.Get("a"); ok {
+			t.Error("expected miss after real TTL")
+		}
+	})
+
+	t.Run("expires negative entries independently", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		c := cache.NewLRUWithClock[string, int](4, time.Hour, time.Second, fake)
+		c.Put("some", maybe.Just(1))
+		c.Put("none", maybe.Empty[int]())
+
+		fake.Advance(time.Second)
+		if _, ok := c.Get("none"); ok {
+			t.Error("negative entry should expire after negative TTL")
+		}
+		if _, ok := c.Get("some"); !ok {
+			t.Error("Some entry should outlive negative TTL")
+		}
+	})
+}
+
+func TestLRU_GetOrLoad(t *testing.T) {
+	t.Run("loads once and serves from cache", func(t *testing.T) {
+		c := cache.NewLRU[int, string](4, 0, 0)
+		calls := 0
+		load := func(k int) maybe.Maybe[string] {
+			calls++
+			return maybe.Just("v")
+		}
+
+		c.GetOrLoad(1, load)
+		result := c.GetOrLoad(1, load)
+		if v, _, _ := result.Get(); v != "v" || calls != 1 {
+			t.Errorf("expected cached v after one load, got %q with %d calls", v, calls)
+		}
+	})
+
+	t.Run("reloads uncached negative results", func(t *testing.T) {
+		c := cache.NewLRU[int, string](4, 0, 0)
+		calls := 0
+		load := func(k int) maybe.Maybe[string] {
+			calls++
+			return maybe.Empty[string]()
+		}
+
+		c.GetOrLoad(1, load)
+		c.GetOrLoad(1, load)
+		if calls != 2 {
+			t.Errorf("expected 2 loads, got %d", calls)
+		}
+	})
+
+	t.Run("converts load panic to Failure", func(t *testing.T) {
+		c := cache.NewLRU[int, string](4, 0, time.Minute)
+		result := c.GetOrLoad(1, func(k int) maybe.Maybe[string] { panic("boom") })
+
+		if _, ok := result.(maybe.Failure[string]); !ok {
+			t.Fatal("expected Failure when load panics")
+		}
+		if _, ok := c.Get(1); !ok {
+			t.Error("panic Failure should be negatively cached")
+		}
+	})
+}
+
+func TestLRU_Remove(t *testing.T) {
+	t.Run("removes entry", func(t *testing.T) {
+		c := cache.NewLRU[string, int](2, 0, 0)
+		c.Put("a", maybe.Just(1))
+		c.Remove("a")
+		c.Remove("missing")
+
+		if _, ok := c.Get("a"); ok {
+			t.Error("entry should be removed")
+		}
+	})
+}
+
+func TestLRU_Concurrent(t *testing.T) {
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		c := cache.NewLRU[int, int](50, time.Minute, time.Minute)
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				c.GetOrLoad(i%60, func(k int) maybe.Maybe[int] { return maybe.Just(k) })
+			}(i)
+		}
+		wg.Wait()
+
+		if c.Len() > 50 {
+			t.Errorf("cache exceeded capacity: %d", c.Len())
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package capability
+
+import "github.com/lonelywolflee/lw-project-fp-go/maybe"
+
+// Supports reports whether v implements the interface (or has the dynamic type) I,
+// returning v as an I when it does. It replaces the comma-ok type assertions used to
+// detect optional capabilities, such as an io.Reader that is also an io.ReaderAt.
+//
+// Behavior:
+//   - If v holds a value assignable to I: returns Just(v.(I))
+//   - Otherwise, including a nil v: returns None
+//
+// Example:
+//
+//	type sizer interface{ Size() int64 }
+//
+//	size := maybe.Map(capability.Supports[sizer](r), sizer.Size).OrElseDefault(-1)
+func Supports[I any](v any) maybe.Maybe[I] {
+	if i, ok := v.(I); ok {
+		return maybe.Just(i)
+	}
+	return maybe.Empty[I]()
+}
+
+// As upgrades the value inside m to T when its dynamic value supports T, as Supports does.
+//
+// Behavior:
+//   - If m is Some and its value implements T: returns Just(value as T)
+//   - If m is Some and its value does not implement T: returns None
+//   - If m is None or Failure: returns it with the type changed
+//
+// Example:
+//
+//	src := openSource(path) // maybe.Maybe[io.Reader]
+//
+//	capability.As[io.ReaderAt](src).Then(func(ra io.ReaderAt) {
+//	    readParallel(ra) // only for sources that support random access
+//	})
+func As[T, S any](m maybe.Maybe[S]) maybe.Maybe[T] {
+	return maybe.FlatMap(m, func(v S) maybe.Maybe[T] {
+		return Supports[T](v)
+	})
+}
@@ -0,0 +1,82 @@
+package capability_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/capability"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// plainReader hides every capability of its underlying reader except Read.
+type plainReader struct{ r io.Reader }
+
+func (p plainReader) Read(b []byte) (int, error) { return p.r.Read(b) }
+
+func TestSupports(t *testing.T) {
+	t.Run("returns value when interface is implemented", func(t *testing.T) {
+		r := io.Reader(strings.NewReader("data"))
+		ra, ok, _ := capability.Supports[io.ReaderAt](r).Get()
+		if !ok {
+			t.Fatal("expected strings.Reader to support io.ReaderAt")
+		}
+		buf := make([]byte, 2)
+		if _, err := ra.ReadAt(buf, 2); err != nil || string(buf) != "ta" {
+			t.Errorf("unexpected ReadAt result %q %v", buf, err)
+		}
+	})
+
+	t.Run("returns None when interface is missing", func(t *testing.T) {
+		if !capability.Supports[io.ReaderAt](plainReader{strings.NewReader("x")}).IsNone() {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("returns None for nil", func(t *testing.T) {
+		if !capability.Supports[io.Reader](nil).IsNone() {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("works with concrete types", func(t *testing.T) {
+		if !capability.Supports[*bytes.Buffer](io.Writer(&bytes.Buffer{})).IsSome() {
+			t.Error("expected Some")
+		}
+	})
+}
+
+func TestAs(t *testing.T) {
+	t.Run("upgrades Some when supported", func(t *testing.T) {
+		var src maybe.Maybe[io.Reader] = maybe.Just[io.Reader](strings.NewReader("data"))
+		if !capability.As[io.Seeker](src).IsSome() {
+			t.Error("expected Some")
+		}
+	})
+
+	t.Run("returns None when unsupported", func(t *testing.T) {
+		var src maybe.Maybe[io.Reader] = maybe.Just[io.Reader](plainReader{strings.NewReader("x")})
+		if !capability.As[io.Seeker](src).IsNone() {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("propagates None and Failure", func(t *testing.T) {
+		err := errors.New("open failed")
+		if !capability.As[io.Seeker](maybe.Maybe[io.Reader](maybe.Empty[io.Reader]())).IsNone() {
+			t.Error("expected None")
+		}
+		if !capability.As[io.Seeker](maybe.Maybe[io.Reader](maybe.Failed[io.Reader](err))).ErrIs(err) {
+			t.Error("expected original Failure")
+		}
+	})
+
+	t.Run("accepts Maybe[any]", func(t *testing.T) {
+		var v maybe.Maybe[any] = maybe.Just[any](42)
+		if got := capability.As[int](v).OrPanic(); got != 42 {
+			t.Errorf("expected 42, got %d", got)
+		}
+	})
+}
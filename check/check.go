@@ -0,0 +1,89 @@
+package check
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Error is the error carried by a Failure produced by a failed check.
+// Use errors.As to tell precondition failures apart from other errors.
+type Error struct {
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// That returns Just(struct{}{}) when cond holds, otherwise a Failure with msg.
+//
+// Example:
+//
+//	check.That(len(name) > 0, "name is required")         // Just(struct{}{})
+//	check.That(qty > 0, "quantity must be positive")      // Failed(*check.Error)
+func That(cond bool, msg string) maybe.Maybe[struct{}] {
+	if cond {
+		return maybe.Just(struct{}{})
+	}
+	return maybe.Failed[struct{}](&Error{Msg: msg})
+}
+
+// NotNil fails when v is nil, including typed nil pointers, maps, slices,
+// channels, functions, and interfaces stored in v.
+//
+// Example:
+//
+//	check.NotNil(req.User) // Failed(*check.Error("value must not be nil")) if req.User is nil
+func NotNil(v any) maybe.Maybe[struct{}] {
+	return That(!isNil(v), "value must not be nil")
+}
+
+// InRange fails unless lo <= v <= hi.
+//
+// Example:
+//
+//	check.InRange(age, 0, 150)  // Just(struct{}{}) for age 30
+//	check.InRange(200, 0, 150)  // Failed(*check.Error("value 200 out of range [0, 150]"))
+func InRange[N cmp.Ordered](v, lo, hi N) maybe.Maybe[struct{}] {
+	return That(lo <= v && v <= hi, fmt.Sprintf("value %v out of range [%v, %v]", v, lo, hi))
+}
+
+// All returns the first non-Some check, or Just(struct{}{}) when every check passes.
+// It replaces a block of "if !cond { return err }" preambles with a single expression.
+//
+// Example:
+//
+//	func (s *Service) Transfer(from, to *Account, amount int64) error {
+//	    _, err := check.All(
+//	        check.NotNil(from),
+//	        check.NotNil(to),
+//	        check.That(from != to, "accounts must differ"),
+//	        check.InRange(amount, 1, 1_000_000),
+//	    ).OrError()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    ...
+//	}
+func All(checks ...maybe.Maybe[struct{}]) maybe.Maybe[struct{}] {
+	for _, c := range checks {
+		if _, ok, _ := c.Get(); !ok {
+			return c
+		}
+	}
+	return maybe.Just(struct{}{})
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/check"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func checkErr(t *testing.T, m maybe.Maybe[struct{}]) *check.Error {
+	t.Helper()
+	_, _, err := m.Get()
+	var checkErr *check.Error
+	if !errors.As(err, &checkErr) {
+		t.Fatalf("expected *check.Error, got %v", err)
+	}
+	return checkErr
+}
+
+func TestThat(t *testing.T) {
+	t.Run("returns Some when condition holds", func(t *testing.T) {
+		if _, ok := check.That(true, "unused").(maybe.Some[struct{}]); !ok {
+			t.Fatal("expected Some for true condition")
+		}
+	})
+
+	t.Run("returns Failure with message when condition fails", func(t *testing.T) {
+		err := checkErr(t, check.That(false, "name is required"))
+		if err.Error() != "name is required" {
+			t.Errorf("expected message, got %q", err.Error())
+		}
+	})
+}
+
+func TestNotNil(t *testing.T) {
+	var nilPtr *int
+	var nilMap map[string]int
+	var nilSlice []int
+	var nilFunc func()
+	var nilIface error
+	value := 1
+
+	passing := map[string]any{
+		"pointer": &value,
+		"int":     0,
+		"string":  "",
+		"map":     map[string]int{},
+		"slice":   []int{},
+	}
+	for name, v := range passing {
+		t.Run("passes non-nil "+name, func(t *testing.T) {
+			if _, ok := check.NotNil(v).(maybe.Some[struct{}]); !ok {
+				t.Errorf("expected Some for %v", v)
+			}
+		})
+	}
+
+	failing := map[string]any{
+		"untyped nil": nil,
+		"pointer":     nilPtr,
+		"map":         nilMap,
+		"slice":       nilSlice,
+		"func":        nilFunc,
+		"interface":   nilIface,
+	}
+	for name, v := range failing {
+		t.Run("fails nil "+name, func(t *testing.T) {
+			checkErr(t, check.NotNil(v))
+		})
+	}
+}
+
+func TestInRange(t *testing.T) {
+	t.Run("passes values within bounds inclusively", func(t *testing.T) {
+		for _, v := range []int{0, 5, 10} {
+			if _, ok := check.InRange(v, 0, 10).(maybe.Some[struct{}]); !ok {
+				t.Errorf("expected Some for %d", v)
+			}
+		}
+	})
+
+	t.Run("fails values outside bounds", func(t *testing.T) {
+		err := checkErr(t, check.InRange(11, 0, 10))
+		if err.Error() != "value 11 out of range [0, 10]" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+		checkErr(t, check.InRange(-1, 0, 10))
+	})
+
+	t.Run("works with other ordered types", func(t *testing.T) {
+		if _, ok := check.InRange("m", "a", "z").(maybe.Some[struct{}]); !ok {
+			t.Error("expected Some for string in range")
+		}
+		checkErr(t, check.InRange(1.5, 2.0, 3.0))
+	})
+}
+
+func TestAll(t *testing.T) {
+	t.Run("returns Some when every check passes", func(t *testing.T) {
+		result := check.All(check.That(true, "a"), check.NotNil(1), check.InRange(1, 0, 2))
+		if _, ok := result.(maybe.Some[struct{}]); !ok {
+			t.Fatal("expected Some when all checks pass")
+		}
+	})
+
+	t.Run("returns Some for no checks", func(t *testing.T) {
+		if _, ok := check.All().(maybe.Some[struct{}]); !ok {
+			t.Fatal("expected Some for no checks")
+		}
+	})
+
+	t.Run("returns the first failing check", func(t *testing.T) {
+		err := checkErr(t, check.All(check.That(true, "a"), check.That(false, "b"), check.That(false, "c")))
+		if err.Msg != "b" {
+			t.Errorf("expected first failure b, got %q", err.Msg)
+		}
+	})
+
+	t.Run("propagates None and plain Failures", func(t *testing.T) {
+		if _, ok := check.All(maybe.Empty[struct{}]()).(maybe.None[struct{}]); !ok {
+			t.Error("expected None to be propagated")
+		}
+		otherErr := errors.New("other")
+		if _, _, err := check.All(maybe.Failed[struct{}](otherErr)).Get(); err != otherErr {
+			t.Errorf("expected other error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+var (
+	// ErrUnknownStep is returned when ResumeFrom names a step that is not part of the pipeline.
+	ErrUnknownStep = errors.New("checkpoint: unknown step")
+	// ErrNoCheckpoint is returned when ResumeFrom names a step that has no saved checkpoint.
+	ErrNoCheckpoint = errors.New("checkpoint: no checkpoint saved")
+)
+
+// Store persists the value produced by each completed step, keyed by step name.
+// Implementations may write to disk, a database, or an object store; MemoryStore
+// is provided for tests and single-process use.
+type Store[T any] interface {
+	// Load returns the saved value for step and whether one exists.
+	Load(step string) (T, bool, error)
+	// Save records the value produced by step, replacing any previous value.
+	Save(step string, value T) error
+	// Delete removes the saved value for step. Deleting a step with no value is not an error.
+	Delete(step string) error
+}
+
+// Step is a named stage of a Pipeline. Name identifies the stage's checkpoint in the Store,
+// so it must be unique and stable across restarts.
+type Step[T any] struct {
+	Name string
+	Fn   func(T) maybe.Maybe[T]
+}
+
+// Pipeline runs a sequence of Steps and checkpoints every successful intermediate value,
+// so an interrupted run can restart without redoing the completed stages.
+//
+// Example:
+//
+//	p := checkpoint.New(store,
+//	    checkpoint.Step[Batch]{Name: "download", Fn: download},
+//	    checkpoint.Step[Batch]{Name: "transform", Fn: transform},
+//	    checkpoint.Step[Batch]{Name: "upload", Fn: upload},
+//	)
+//
+//	result := p.Resume(batch) // skips the stages finished by a previous run
+type Pipeline[T any] struct {
+	store Store[T]
+	steps []Step[T]
+}
+
+// New creates a Pipeline that checkpoints into store.
+func New[T any](store Store[T], steps ...Step[T]) *Pipeline[T] {
+	return &Pipeline[T]{store: store, steps: steps}
+}
+
+// Run executes every step from the beginning, saving each step's value after it succeeds.
+// Checkpoints left by an earlier run are deleted first, so a later Resume never mixes
+// values from two runs.
+//
+// Behavior:
+//   - If every step returns Some: returns Just(final value) and deletes the checkpoints,
+//     so the next Resume starts a new run
+//   - If a step returns None: stops and returns None (nothing is saved for that step)
+//   - If a step returns Failure or panics: stops and returns the Failure
+//   - If the Store fails to save or delete: returns Failure with the store error
+//
+// Example:
+//
+//	result := p.Run(batch)
+func (p *Pipeline[T]) Run(initial T) maybe.Maybe[T] {
+	if err := p.clear(); err != nil {
+		return maybe.Failed[T](err)
+	}
+	return p.runFrom(0, initial)
+}
+
+// Resume continues after the latest step that has a checkpoint, or runs from the
+// beginning with initial when no step has one. Because a completed run deletes its
+// checkpoints, Resume after a completed run starts over with initial.
+//
+// Example:
+//
+//	// First run crashed during "upload"; this call loads the "transform"
+//	// checkpoint and only runs "upload".
+//	result := p.Resume(batch)
+func (p *Pipeline[T]) Resume(initial T) maybe.Maybe[T] {
+	for i := len(p.steps) - 1; i >= 0; i-- {
+		value, ok, err := p.store.Load(p.steps[i].Name)
+		if err != nil {
+			return maybe.Failed[T](fmt.Errorf("checkpoint: load %q: %w", p.steps[i].Name, err))
+		}
+		if ok {
+			return p.runFrom(i+1, value)
+		}
+	}
+	return p.runFrom(0, initial)
+}
+
+// ResumeFrom loads the checkpoint saved by the named step and runs the steps after it.
+//
+// Behavior:
+//   - If step is not in the pipeline: returns Failure wrapping ErrUnknownStep
+//   - If step has no checkpoint: returns Failure wrapping ErrNoCheckpoint
+//   - Otherwise behaves like Run for the remaining steps
+//
+// Example:
+//
+//	result := p.ResumeFrom("transform") // only "upload" runs
+func (p *Pipeline[T]) ResumeFrom(step string) maybe.Maybe[T] {
+	for i, s := range p.steps {
+		if s.Name != step {
+			continue
+		}
+		value, ok, err := p.store.Load(step)
+		if err != nil {
+			return maybe.Failed[T](fmt.Errorf("checkpoint: load %q: %w", step, err))
+		}
+		if !ok {
+			return maybe.Failed[T](fmt.Errorf("%w for step %q", ErrNoCheckpoint, step))
+		}
+		return p.runFrom(i+1, value)
+	}
+	return maybe.Failed[T](fmt.Errorf("%w %q", ErrUnknownStep, step))
+}
+
+// runFrom executes the steps starting at index start.
+func (p *Pipeline[T]) runFrom(start int, value T) maybe.Maybe[T] {
+	var current maybe.Maybe[T] = maybe.Just(value)
+	for _, step := range p.steps[start:] {
+		current = maybe.FlatMap(current, step.Fn)
+		next, ok, err := current.Get()
+		if !ok || err != nil {
+			return current
+		}
+		if err := p.store.Save(step.Name, next); err != nil {
+			return maybe.Failed[T](fmt.Errorf("checkpoint: save %q: %w", step.Name, err))
+		}
+	}
+	if err := p.clear(); err != nil {
+		return maybe.Failed[T](err)
+	}
+	return current
+}
+
+// clear deletes the checkpoint of every step.
+func (p *Pipeline[T]) clear() error {
+	for _, step := range p.steps {
+		if err := p.store.Delete(step.Name); err != nil {
+			return fmt.Errorf("checkpoint: delete %q: %w", step.Name, err)
+		}
+	}
+	return nil
+}
+
+// MemoryStore is an in-memory Store safe for concurrent use.
+type MemoryStore[T any] struct {
+	mu     sync.Mutex
+	values map[string]T
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore[T any]() *MemoryStore[T] {
+	return &MemoryStore[T]{values: map[string]T{}}
+}
+
+// Load returns the value saved for step.
+func (s *MemoryStore[T]) Load(step string) (T, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[step]
+	return v, ok, nil
+}
+
+// Save records value for step.
+func (s *MemoryStore[T]) Save(step string, value T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[step] = value
+	return nil
+}
+
+// Delete removes the value saved for step.
+func (s *MemoryStore[T]) Delete(step string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, step)
+	return nil
+}
@@ -0,0 +1,252 @@
+package checkpoint_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/checkpoint"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type failingStore struct {
+	loadErr   error
+	saveErr   error
+	deleteErr error
+}
+
+func (s failingStore) Load(step string) (int, bool, error) { return 0, false, s.loadErr }
+func (s failingStore) Save(step string, value int) error   { return s.saveErr }
+func (s failingStore) Delete(step string) error            { return s.deleteErr }
+
+// savedSteps returns the steps that have a checkpoint in store.
+func savedSteps(store checkpoint.Store[int], names ...string) []string {
+	var saved []string
+	for _, name := range names {
+		if _, ok, _ := store.Load(name); ok {
+			saved = append(saved, name)
+		}
+	}
+	return saved
+}
+
+// steps builds add/double/square stages that record each name they run in calls.
+func steps(calls *[]string) []checkpoint.Step[int] {
+	stage := func(name string, fn func(int) int) checkpoint.Step[int] {
+		return checkpoint.Step[int]{Name: name, Fn: func(v int) maybe.Maybe[int] {
+			*calls = append(*calls, name)
+			return maybe.Just(fn(v))
+		}}
+	}
+	return []checkpoint.Step[int]{
+		stage("add", func(v int) int { return v + 1 }),
+		stage("double", func(v int) int { return v * 2 }),
+		stage("square", func(v int) int { return v * v }),
+	}
+}
+
+func TestPipeline_Run(t *testing.T) {
+	t.Run("saves checkpoints until a step fails", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+		stages := steps(&calls)
+		stages[2].Fn = func(int) maybe.Maybe[int] { return maybe.Failed[int](errors.New("upload failed")) }
+
+		checkpoint.New(store, stages...).Run(2)
+		for name, want := range map[string]int{"add": 3, "double": 6} {
+			if v, ok, _ := store.Load(name); !ok || v != want {
+				t.Errorf("checkpoint %s: expected %d, got %d (ok=%v)", name, want, v, ok)
+			}
+		}
+	})
+
+	t.Run("runs every step and clears checkpoints on success", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+
+		result := checkpoint.New(store, steps(&calls)...).Run(2)
+		if v, ok, _ := result.Get(); !ok || v != 36 {
+			t.Errorf("expected 36, got %d", v)
+		}
+		if saved := savedSteps(store, "add", "double", "square"); len(saved) != 0 {
+			t.Errorf("expected checkpoints to be cleared, found %v", saved)
+		}
+	})
+
+	t.Run("discards checkpoints of a partially failed older run", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+		older := steps(&calls)
+		older[2].Fn = func(int) maybe.Maybe[int] { return maybe.Failed[int](errors.New("crash")) }
+		checkpoint.New(store, older...).Run(2) // leaves "add" and "double"
+
+		newer := steps(&calls)
+		newer[0].Fn = func(int) maybe.Maybe[int] { return maybe.Failed[int](errors.New("crash")) }
+		checkpoint.New(store, newer...).Run(10)
+		if saved := savedSteps(store, "add", "double", "square"); len(saved) != 0 {
+			t.Errorf("expected stale checkpoints to be discarded, found %v", saved)
+		}
+
+		calls = nil
+		result := checkpoint.New(store, steps(&calls)...).Resume(10)
+		if v, _, _ := result.Get(); v != 484 || len(calls) != 3 {
+			t.Errorf("expected a fresh run to 484, got %d after %v", v, calls)
+		}
+	})
+
+	t.Run("returns Failure when deleting fails", func(t *testing.T) {
+		deleteErr := errors.New("read-only")
+		var calls []string
+
+		_, _, err := checkpoint.New[int](failingStore{deleteErr: deleteErr}, steps(&calls)...).Run(1).Get()
+		if !errors.Is(err, deleteErr) || len(calls) != 0 {
+			t.Errorf("expected delete error before any step, got %v after %v", err, calls)
+		}
+	})
+
+	t.Run("stops at None without saving", func(t *testing.T) {
+		store := checkpoint.NewMemoryStore[int]()
+		p := checkpoint.New(store,
+			checkpoint.Step[int]{Name: "empty", Fn: func(int) maybe.Maybe[int] { return maybe.Empty[int]() }},
+			checkpoint.Step[int]{Name: "never", Fn: func(int) maybe.Maybe[int] { t.Error("should not run"); return maybe.Just(0) }},
+		)
+
+		if _, ok := p.Run(1).(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+		if _, ok, _ := store.Load("empty"); ok {
+			t.Error("None result should not be checkpointed")
+		}
+	})
+
+	t.Run("stops at Failure and converts panics", func(t *testing.T) {
+		p := checkpoint.New[int](checkpoint.NewMemoryStore[int](),
+			checkpoint.Step[int]{Name: "boom", Fn: func(int) maybe.Maybe[int] { panic("boom") }},
+		)
+
+		if _, ok := p.Run(1).(maybe.Failure[int]); !ok {
+			t.Error("expected Failure when a step panics")
+		}
+	})
+
+	t.Run("returns Failure when saving fails", func(t *testing.T) {
+		saveErr := errors.New("disk full")
+		var calls []string
+
+		_, _, err := checkpoint.New[int](failingStore{saveErr: saveErr}, steps(&calls)...).Run(1).Get()
+		if !errors.Is(err, saveErr) || !strings.Contains(err.Error(), `"add"`) {
+			t.Errorf("expected save error for add, got %v", err)
+		}
+		if len(calls) != 1 {
+			t.Errorf("expected pipeline to stop after first step, ran %v", calls)
+		}
+	})
+}
+
+func TestPipeline_Resume(t *testing.T) {
+	t.Run("continues after latest checkpoint", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+		_ = store.Save("add", 3)
+		_ = store.Save("double", 6)
+
+		result := checkpoint.New(store, steps(&calls)...).Resume(100)
+		if v, _, _ := result.Get(); v != 36 {
+			t.Errorf("expected 36, got %d", v)
+		}
+		if strings.Join(calls, ",") != "square" {
+			t.Errorf("expected only square to run, got %v", calls)
+		}
+	})
+
+	t.Run("runs from the beginning without checkpoints", func(t *testing.T) {
+		var calls []string
+
+		result := checkpoint.New(checkpoint.NewMemoryStore[int](), steps(&calls)...).Resume(2)
+		if v, _, _ := result.Get(); v != 36 || len(calls) != 3 {
+			t.Errorf("expected full run to 36, got %d after %v", v, calls)
+		}
+	})
+
+	t.Run("starts over after a completed run", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+		p := checkpoint.New(store, steps(&calls)...)
+		p.Run(2)
+
+		calls = nil
+		if v, _, _ := p.Resume(3).Get(); v != 64 || len(calls) != 3 {
+			t.Errorf("expected a new run to 64, got %d after %v", v, calls)
+		}
+	})
+
+	t.Run("returns final value when everything is checkpointed", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+		_ = store.Save("square", 49)
+
+		if v, _, _ := checkpoint.New(store, steps(&calls)...).Resume(0).Get(); v != 49 || len(calls) != 0 {
+			t.Errorf("expected 49 with no steps run, got %d after %v", v, calls)
+		}
+	})
+
+	t.Run("returns Failure when clearing a completed run fails", func(t *testing.T) {
+		deleteErr := errors.New("read-only")
+		var calls []string
+
+		_, _, err := checkpoint.New[int](failingStore{deleteErr: deleteErr}, steps(&calls)...).Resume(1).Get()
+		if !errors.Is(err, deleteErr) || len(calls) != 3 {
+			t.Errorf("expected delete error after all steps, got %v after %v", err, calls)
+		}
+	})
+
+	t.Run("returns Failure when loading fails", func(t *testing.T) {
+		loadErr := errors.New("unreachable")
+		var calls []string
+
+		if _, _, err := checkpoint.New[int](failingStore{loadErr: loadErr}, steps(&calls)...).Resume(0).Get(); !errors.Is(err, loadErr) {
+			t.Errorf("expected load error, got %v", err)
+		}
+	})
+}
+
+func TestPipeline_ResumeFrom(t *testing.T) {
+	t.Run("runs the steps after the named checkpoint", func(t *testing.T) {
+		var calls []string
+		store := checkpoint.NewMemoryStore[int]()
+		_ = store.Save("add", 4)
+
+		result := checkpoint.New(store, steps(&calls)...).ResumeFrom("add")
+		if v, _, _ := result.Get(); v != 64 {
+			t.Errorf("expected 64, got %d", v)
+		}
+		if strings.Join(calls, ",") != "double,square" {
+			t.Errorf("expected double,square, got %v", calls)
+		}
+	})
+
+	t.Run("fails for unknown step", func(t *testing.T) {
+		var calls []string
+
+		if _, _, err := checkpoint.New(checkpoint.NewMemoryStore[int](), steps(&calls)...).ResumeFrom("nope").Get(); !errors.Is(err, checkpoint.ErrUnknownStep) {
+			t.Errorf("expected ErrUnknownStep, got %v", err)
+		}
+	})
+
+	t.Run("fails when step has no checkpoint", func(t *testing.T) {
+		var calls []string
+
+		if _, _, err := checkpoint.New(checkpoint.NewMemoryStore[int](), steps(&calls)...).ResumeFrom("double").Get(); !errors.Is(err, checkpoint.ErrNoCheckpoint) {
+			t.Errorf("expected ErrNoCheckpoint, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure when loading fails", func(t *testing.T) {
+		loadErr := errors.New("unreachable")
+		var calls []string
+
+		if _, _, err := checkpoint.New[int](failingStore{loadErr: loadErr}, steps(&calls)...).ResumeFrom("add").Get(); !errors.Is(err, loadErr) {
+			t.Errorf("expected load error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package choose
+
+import (
+	"hash/fnv"
+	"math"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/randsrc"
+)
+
+// Option is a candidate value with a relative weight. Options with a weight of zero or less
+// (or NaN) never qualify.
+type Option[T any] struct {
+	Value  T
+	Weight float64
+}
+
+// Weighted picks an option at random with probability proportional to its weight,
+// using randsrc.Default.
+//
+// Behavior:
+//   - If at least one option has a positive weight: returns Just(picked value)
+//   - Otherwise (no options, or all weights <= 0): returns None
+//
+// Example:
+//
+//	backend := choose.Weighted([]choose.Option[string]{
+//	    {Value: "stable", Weight: 95},
+//	    {Value: "canary", Weight: 5},
+//	})
+func Weighted[T any](options []Option[T]) maybe.Maybe[T] {
+	return WeightedFrom(randsrc.Default, options)
+}
+
+// WeightedFrom is Weighted drawing from src, so tests can use a seeded randsrc.New.
+// A nil src uses randsrc.Default.
+//
+// Example:
+//
+//	variant := choose.WeightedFrom(randsrc.New(1), options) // same pick on every run
+func WeightedFrom[T any](src randsrc.Source, options []Option[T]) maybe.Maybe[T] {
+	total := totalWeight(options)
+	if total == 0 {
+		return maybe.Empty[T]()
+	}
+	return pick(options, randsrc.OrDefault(src).Float64()*total)
+}
+
+// ByHash picks an option deterministically from key, with probability proportional to weight
+// across keys. The same key and options always produce the same pick, which makes it
+// suitable for sticky A/B assignment by user or session ID.
+//
+// Behavior:
+//   - If at least one option has a positive weight: returns Just(picked value)
+//   - Otherwise: returns None
+//
+// Example:
+//
+//	variant := choose.ByHash(user.ID, []choose.Option[string]{
+//	    {Value: "control", Weight: 50},
+//	    {Value: "new-checkout", Weight: 50},
+//	}) // the same user always sees the same variant
+func ByHash[T any](key string, options []Option[T]) maybe.Maybe[T] {
+	total := totalWeight(options)
+	if total == 0 {
+		return maybe.Empty[T]()
+	}
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	fraction := float64(h.Sum64()>>11) / (1 << 53)
+	return pick(options, fraction*total)
+}
+
+func qualifies(w float64) bool {
+	return w > 0 && !math.IsInf(w, 1)
+}
+
+func totalWeight[T any](options []Option[T]) float64 {
+	total := 0.0
+	for _, o := range options {
+		if qualifies(o.Weight) {
+			total += o.Weight
+		}
+	}
+	return total
+}
+
+// pick returns the option whose cumulative weight range contains target, which must be in [0, total).
+func pick[T any](options []Option[T], target float64) maybe.Maybe[T] {
+	var last T
+	for _, o := range options {
+		if !qualifies(o.Weight) {
+			continue
+		}
+		last = o.Value
+		if target < o.Weight {
+			return maybe.Just(o.Value)
+		}
+		target -= o.Weight
+	}
+	// Floating-point rounding can leave target just above the final boundary.
+	return maybe.Just(last)
+}
@@ -0,0 +1,128 @@
+package choose_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/choose"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/randsrc"
+)
+
+// fixedSource returns the same Float64 on every call.
+type fixedSource struct{ f float64 }
+
+func (s fixedSource) Uint64() uint64   { return 0 }
+func (s fixedSource) Float64() float64 { return s.f }
+func (s fixedSource) IntN(int) int     { return 0 }
+
+var abOptions = []choose.Option[string]{
+	{Value: "a", Weight: 1},
+	{Value: "off", Weight: 0},
+	{Value: "b", Weight: 3},
+}
+
+func TestWeighted(t *testing.T) {
+	t.Run("returns one of the qualifying options", func(t *testing.T) {
+		v, ok, err := choose.Weighted(abOptions).Get()
+		if !ok || err != nil || (v != "a" && v != "b") {
+			t.Errorf("expected a or b, got %q %v %v", v, ok, err)
+		}
+	})
+}
+
+func TestWeightedFrom(t *testing.T) {
+	t.Run("maps the draw onto cumulative weights", func(t *testing.T) {
+		cases := []struct {
+			f    float64
+			want string
+		}{{0, "a"}, {0.2, "a"}, {0.25, "b"}, {0.99, "b"}}
+		for _, c := range cases {
+			got := choose.WeightedFrom(fixedSource{c.f}, abOptions).OrPanic()
+			if got != c.want {
+				t.Errorf("draw %v: expected %s, got %s", c.f, c.want, got)
+			}
+		}
+	})
+
+	t.Run("follows the weights over many draws", func(t *testing.T) {
+		src := randsrc.New(7)
+		counts := map[string]int{}
+		for range 4000 {
+			counts[choose.WeightedFrom(src, abOptions).OrPanic()]++
+		}
+		if counts["off"] != 0 {
+			t.Errorf("zero-weight option was picked %d times", counts["off"])
+		}
+		if counts["b"] < 2*counts["a"] {
+			t.Errorf("expected b about three times as often as a, got %v", counts)
+		}
+	})
+
+	t.Run("is reproducible with a seeded source", func(t *testing.T) {
+		for range 10 {
+			x := choose.WeightedFrom(randsrc.New(1), abOptions).OrPanic()
+			y := choose.WeightedFrom(randsrc.New(1), abOptions).OrPanic()
+			if x != y {
+				t.Fatalf("expected same pick, got %s and %s", x, y)
+			}
+		}
+	})
+
+	t.Run("returns last qualifying option when rounding overshoots", func(t *testing.T) {
+		options := []choose.Option[string]{{Value: "x", Weight: 0.3}, {Value: "y", Weight: 0.7}}
+		got := choose.WeightedFrom(fixedSource{math.Nextafter(1, 0)}, options).OrPanic()
+		if got != "y" {
+			t.Errorf("expected y, got %s", got)
+		}
+	})
+
+	t.Run("nil source uses default", func(t *testing.T) {
+		if _, ok := choose.WeightedFrom(nil, abOptions).(maybe.Some[string]); !ok {
+			t.Error("expected Some")
+		}
+	})
+
+	t.Run("returns None when no option qualifies", func(t *testing.T) {
+		options := []choose.Option[string]{
+			{Value: "neg", Weight: -1},
+			{Value: "nan", Weight: math.NaN()},
+			{Value: "inf", Weight: math.Inf(1)},
+		}
+		for name, opts := range map[string][]choose.Option[string]{"empty": nil, "non-positive": options} {
+			if _, ok := choose.WeightedFrom(randsrc.New(1), opts).(maybe.None[string]); !ok {
+				t.Errorf("%s: expected None", name)
+			}
+		}
+	})
+}
+
+func TestByHash(t *testing.T) {
+	t.Run("is deterministic per key", func(t *testing.T) {
+		for _, key := range []string{"user-1", "user-2", "session-xyz"} {
+			first := choose.ByHash(key, abOptions).OrPanic()
+			for range 5 {
+				if got := choose.ByHash(key, abOptions).OrPanic(); got != first {
+					t.Fatalf("key %s: expected %s, got %s", key, first, got)
+				}
+			}
+		}
+	})
+
+	t.Run("spreads keys according to weights", func(t *testing.T) {
+		counts := map[string]int{}
+		for i := range 4000 {
+			counts[choose.ByHash("user-"+strconv.Itoa(i), abOptions).OrPanic()]++
+		}
+		if counts["off"] != 0 || counts["a"] == 0 || counts["b"] < 2*counts["a"] {
+			t.Errorf("unexpected distribution %v", counts)
+		}
+	})
+
+	t.Run("returns None when no option qualifies", func(t *testing.T) {
+		if _, ok := choose.ByHash("k", []choose.Option[int]{{Value: 1}}).(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestSystem(t *testing.T) {
+	t.Run("reports real time", func(t *testing.T) {
+		before := time.Now()
+		now := clock.System.Now()
+		if now.Before(before) {
+			t.Errorf("System.Now went backwards: %v < %v", now, before)
+		}
+	})
+
+	t.Run("After and Sleep wait for real time", func(t *testing.T) {
+		start := time.Now()
+		<-clock.System.After(time.Millisecond)
+		clock.System.Sleep(time.Millisecond)
+		if time.Since(start) < 2*time.Millisecond {
+			t.Error("System timers returned too early")
+		}
+	})
+}
+
+func TestOrSystem(t *testing.T) {
+	t.Run("returns System for nil", func(t *testing.T) {
+		if clock.OrSystem(nil) != clock.System {
+			t.Error("expected System for nil clock")
+		}
+	})
+
+	t.Run("returns the given clock", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		if clock.OrSystem(fake) != fake {
+			t.Error("expected the given clock")
+		}
+	})
+}
+
+func TestFake(t *testing.T) {
+	t.Run("only moves when advanced", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		if !fake.Now().Equal(epoch) {
+			t.Fatalf("expected %v, got %v", epoch, fake.Now())
+		}
+
+		fake.Advance(time.Hour)
+		if !fake.Now().Equal(epoch.Add(time.Hour)) {
+			t.Errorf("expected %v, got %v", epoch.Add(time.Hour), fake.Now())
+		}
+
+		fake.Set(epoch)
+		if !fake.Now().Equal(epoch) {
+			t.Errorf("expected %v after Set, got %v", epoch, fake.Now())
+		}
+	})
+
+	t.Run("After fires only once the deadline is reached", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		ch := fake.After(time.Minute)
+
+		fake.Advance(59 * time.Second)
+		select {
+		case <-ch:
+			t.Fatal("timer fired before its deadline")
+		default:
+		}
+
+		fake.Advance(time.Second)
+		select {
+		case got := <-ch:
+			if !got.Equal(epoch.Add(time.Minute)) {
+				t.Errorf("expected fire time %v, got %v", epoch.Add(time.Minute), got)
+			}
+		default:
+			t.Fatal("timer did not fire at its deadline")
+		}
+		if fake.Waiters() != 0 {
+			t.Errorf("expected no pending timers, got %d", fake.Waiters())
+		}
+	})
+
+	t.Run("After with non-positive duration fires immediately", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		select {
+		case <-fake.After(0):
+		default:
+			t.Fatal("zero-duration timer should fire immediately")
+		}
+	})
+
+	t.Run("Set fires due timers", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		early := fake.After(time.Second)
+		late := fake.After(time.Hour)
+
+		fake.Set(epoch.Add(time.Minute))
+		select {
+		case <-early:
+		default:
+			t.Error("early timer should fire")
+		}
+		select {
+		case <-late:
+			t.Error("late timer should not fire")
+		default:
+		}
+		if fake.Waiters() != 1 {
+			t.Errorf("expected 1 pending timer, got %d", fake.Waiters())
+		}
+	})
+
+	t.Run("Sleep blocks until advanced", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		done := make(chan struct{})
+		go func() {
+			fake.Sleep(time.Second)
+			close(done)
+		}()
+
+		for fake.Waiters() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		select {
+		case <-done:
+			t.Fatal("Sleep returned before the clock advanced")
+		default:
+		}
+
+		fake.Advance(time.Second)
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Sleep did not return after the clock advanced")
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package cmap
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// DefaultShards is the number of shards used when New is given a non-positive count.
+const DefaultShards = 32
+
+// Map is a sharded concurrent map whose lookups return Maybe values.
+// Keys are spread across independently locked shards to reduce contention.
+//
+// Example:
+//
+//	users := cmap.New[int, User](0)
+//	users.Store(1, alice)
+//	users.Load(1) // Just(alice)
+//	users.Load(2) // Empty[User]()
+type Map[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []*shard[K, V]
+}
+
+type shard[K comparable, V any] struct {
+	mu       sync.RWMutex
+	items    map[K]V
+	inflight map[K]*call[V]
+}
+
+// call is an in-progress LoadOrCompute shared by concurrent callers for the same key.
+type call[V any] struct {
+	done   chan struct{}
+	result maybe.Maybe[V]
+	// superseded is set when Store or Delete touches the key while fn runs.
+	superseded bool
+}
+
+// New creates an empty Map with the given number of shards.
+// A non-positive count uses DefaultShards.
+//
+// Example:
+//
+//	m := cmap.New[string, int](64)
+func New[K comparable, V any](shards int) *Map[K, V] {
+	if shards <= 0 {
+		shards = DefaultShards
+	}
+	m := &Map[K, V]{seed: maphash.MakeSeed(), shards: make([]*shard[K, V], shards)}
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{items: map[K]V{}, inflight: map[K]*call[V]{}}
+	}
+	return m
+}
+
+func (m *Map[K, V]) shardFor(k K) *shard[K, V] {
+	return m.shards[maphash.Comparable(m.seed, k)%uint64(len(m.shards))]
+}
+
+// Load returns the value stored for k.
+//
+// Behavior:
+//   - If k is present: returns Just(value)
+//   - If k is absent: returns None
+//
+// Example:
+//
+//	m.Store("a", 1)
+//	m.Load("a") // Just(1)
+//	m.Load("b") // Empty[int]()
+func (m *Map[K, V]) Load(k K) maybe.Maybe[V] {
+	s := m.shardFor(k)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if v, ok := s.items[k]; ok {
+		return maybe.Just(v)
+	}
+	return maybe.Empty[V]()
+}
+
+// Store sets the value for k, replacing any existing value.
+func (m *Map[K, V]) Store(k K, v V) {
+	s := m.shardFor(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[k] = v
+	s.supersede(k)
+}
+
+// Delete removes k from the map. Deleting an absent key is a no-op.
+func (m *Map[K, V]) Delete(k K) {
+	s := m.shardFor(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.items, k)
+	s.supersede(k)
+}
+
+// supersede marks an in-progress LoadOrCompute for k so it does not overwrite a write made
+// while it ran. The caller must hold s.mu.
+func (s *shard[K, V]) supersede(k K) {
+	if c, ok := s.inflight[k]; ok {
+		c.superseded = true
+	}
+}
+
+// LoadOrCompute returns the value stored for k, computing and storing it if absent.
+// Concurrent callers for the same absent key share a single computation, and fn
+// runs without holding any lock so it may be slow or call back into the map.
+//
+// Behavior:
+//   - If k is present: returns Just(value) (fn not called)
+//   - If fn returns (value, nil): stores value and returns Just(value)
+//   - If fn returns an error: stores nothing and returns Failure to every waiting caller
+//   - If fn panics: stores nothing and returns Failure with the panic converted to an error
+//   - If k is stored while fn runs: the stored value is kept and returned as Just to every
+//     waiting caller, whatever fn returned
+//   - If k is deleted while fn runs: the computed value is returned but not stored, so the
+//     deletion (for example a cache invalidation) is not undone
+//
+// Example:
+//
+//	user := users.LoadOrCompute(id, func() (User, error) {
+//	    return db.FindUser(id) // called at most once per id at a time
+//	})
+func (m *Map[K, V]) LoadOrCompute(k K, fn func() (V, error)) maybe.Maybe[V] {
+	s := m.shardFor(k)
+	s.mu.Lock()
+	if v, ok := s.items[k]; ok {
+		s.mu.Unlock()
+		return maybe.Just(v)
+	}
+	if c, ok := s.inflight[k]; ok {
+		s.mu.Unlock()
+		<-c.done
+		return c.result
+	}
+	c := &call[V]{done: make(chan struct{})}
+	s.inflight[k] = c
+	s.mu.Unlock()
+
+	c.result = maybe.Try(fn)
+
+	s.mu.Lock()
+	delete(s.inflight, k)
+	if stored, ok := s.items[k]; ok && c.superseded {
+		c.result = maybe.Just(stored)
+	} else if v, ok, _ := c.result.Get(); ok && !c.superseded {
+		s.items[k] = v
+	}
+	s.mu.Unlock()
+	close(c.done)
+
+	return c.result
+}
+
+// Range calls fn for each key and value until fn returns false.
+// Each shard is copied before iteration, so fn may safely modify the map;
+// such modifications may or may not be observed by the ongoing Range.
+//
+// Example:
+//
+//	m.Range(func(k string, v int) bool {
+//	    fmt.Println(k, v)
+//	    return v < 100 // stop at the first value >= 100
+//	})
+func (m *Map[K, V]) Range(fn func(K, V) bool) {
+	for _, s := range m.shards {
+		for k, v := range s.snapshot() {
+			if !fn(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a copy of the map's contents for export.
+// Shards are copied one at a time, so the copy is consistent per shard rather than globally.
+//
+// Example:
+//
+//	export := m.Snapshot() // map[string]int{"a": 1, ...}
+func (m *Map[K, V]) Snapshot() map[K]V {
+	out := map[K]V{}
+	for _, s := range m.shards {
+		for k, v := range s.snapshot() {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Len returns the number of stored keys.
+func (m *Map[K, V]) Len() int {
+	n := 0
+	for _, s := range m.shards {
+		s.mu.RLock()
+		n += len(s.items)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+func (s *shard[K, V]) snapshot() map[K]V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make(map[K]V, len(s.items))
+	for k, v := range s.items {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,272 @@
+package cmap_test
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/cmap"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestMap_LoadStoreDelete(t *testing.T) {
+	t.Run("Load returns None for absent key", func(t *testing.T) {
+		m := cmap.New[string, int](0)
+
+		if _, ok := m.Load("missing").(maybe.None[int]); !ok {
+			t.Fatal("Load should return None for absent key")
+		}
+	})
+
+	t.Run("Load returns Some after Store", func(t *testing.T) {
+		m := cmap.New[string, int](4)
+		m.Store("a", 1)
+
+		if v, ok, _ := m.Load("a").Get(); !ok || v != 1 {
+			t.Errorf("expected Just(1), got %d (ok=%v)", v, ok)
+		}
+	})
+
+	t.Run("Store replaces existing value", func(t *testing.T) {
+		m := cmap.New[string, int](4)
+		m.Store("a", 1)
+		m.Store("a", 2)
+
+		if v, _, _ := m.Load("a").Get(); v != 2 {
+			t.Errorf("expected 2, got %d", v)
+		}
+		if m.Len() != 1 {
+			t.Errorf("expected Len 1, got %d", m.Len())
+		}
+	})
+
+	t.Run("Delete removes key", func(t *testing.T) {
+		m := cmap.New[string, int](4)
+		m.Store("a", 1)
+		m.Delete("a")
+		m.Delete("never-stored")
+
+		if _, ok := m.Load("a").(maybe.None[int]); !ok {
+			t.Fatal("Load should return None after Delete")
+		}
+	})
+}
+
+func TestMap_LoadOrCompute(t *testing.T) {
+	t.Run("computes and stores absent value", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		result := m.LoadOrCompute(1, func() (string, error) { return "one", nil })
+
+		if v, ok, _ := result.Get(); !ok || v != "one" {
+			t.Errorf("expected Just(one), got %q", v)
+		}
+		if v, _, _ := m.Load(1).Get(); v != "one" {
+			t.Errorf("computed value should be stored, got %q", v)
+		}
+	})
+
+	t.Run("returns existing value without calling fn", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		m.Store(1, "stored")
+		called := false
+		result := m.LoadOrCompute(1, func() (string, error) { called = true; return "computed", nil })
+
+		if v, _, _ := result.Get(); v != "stored" || called {
+			t.Errorf("expected stored value without calling fn, got %q (called=%v)", v, called)
+		}
+	})
+
+	t.Run("returns Failure and stores nothing on error", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		computeErr := errors.New("lookup failed")
+		result := m.LoadOrCompute(1, func() (string, error) { return "", computeErr })
+
+		if _, _, err := result.Get(); !errors.Is(err, computeErr) {
+			t.Errorf("expected compute error, got %v", err)
+		}
+		if m.Len() != 0 {
+			t.Errorf("failed computation should not be stored, Len=%d", m.Len())
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		result := m.LoadOrCompute(1, func() (string, error) { panic("boom") })
+
+		if _, ok := result.(maybe.Failure[string]); !ok {
+			t.Fatal("expected Failure when fn panics")
+		}
+	})
+
+	t.Run("keeps a value stored while fn runs", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		result := m.LoadOrCompute(1, func() (string, error) {
+			m.Store(1, "stored")
+			return "computed", nil
+		})
+
+		if v, _, _ := result.Get(); v != "stored" {
+			t.Errorf("expected the concurrently stored value, got %q", v)
+		}
+		if v, _, _ := m.Load(1).Get(); v != "stored" {
+			t.Errorf("computed value should not overwrite the store, got %q", v)
+		}
+	})
+
+	t.Run("returns the stored value when fn fails after a store", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		result := m.LoadOrCompute(1, func() (string, error) {
+			m.Store(1, "stored")
+			return "", errors.New("lookup failed")
+		})
+
+		if v, ok, _ := result.Get(); !ok || v != "stored" {
+			t.Errorf("expected Just(stored), got %v", result)
+		}
+	})
+
+	t.Run("does not undo a delete made while fn runs", func(t *testing.T) {
+		m := cmap.New[int, string](0)
+		result := m.LoadOrCompute(1, func() (string, error) {
+			m.Delete(1)
+			return "computed", nil
+		})
+
+		if v, _, _ := result.Get(); v != "computed" {
+			t.Errorf("expected the computed value, got %q", v)
+		}
+		if !m.Load(1).IsNone() {
+			t.Error("computed value should not be stored after a concurrent delete")
+		}
+		m.LoadOrCompute(1, func() (string, error) { return "again", nil })
+		if v, _, _ := m.Load(1).Get(); v != "again" {
+			t.Errorf("a later computation should store again, got %q", v)
+		}
+	})
+
+	t.Run("deduplicates concurrent computations", func(t *testing.T) {
+		m := cmap.New[int, int](0)
+		var calls atomic.Int32
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		results := make([]int, 10)
+
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				v, _, _ := m.LoadOrCompute(1, func() (int, error) {
+					calls.Add(1)
+					<-release
+					return 42, nil
+				}).Get()
+				results[i] = v
+			}(i)
+		}
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		if calls.Load() != 1 {
+			t.Errorf("expected 1 computation, got %d", calls.Load())
+		}
+		for i, v := range results {
+			if v != 42 {
+				t.Errorf("caller %d: expected 42, got %d", i, v)
+			}
+		}
+	})
+}
+
+func TestMap_Range(t *testing.T) {
+	t.Run("visits every entry", func(t *testing.T) {
+		m := cmap.New[int, int](4)
+		for i := 0; i < 20; i++ {
+			m.Store(i, i*i)
+		}
+
+		seen := map[int]int{}
+		m.Range(func(k, v int) bool {
+			seen[k] = v
+			return true
+		})
+		if len(seen) != 20 || seen[3] != 9 {
+			t.Errorf("unexpected entries %v", seen)
+		}
+	})
+
+	t.Run("stops when fn returns false", func(t *testing.T) {
+		m := cmap.New[int, int](4)
+		for i := 0; i < 20; i++ {
+			m.Store(i, i)
+		}
+
+		visits := 0
+		m.Range(func(k, v int) bool {
+			visits++
+			return visits < 3
+		})
+		if visits != 3 {
+			t.Errorf("expected 3 visits, got %d", visits)
+		}
+	})
+
+	t.Run("allows modifying the map during iteration", func(t *testing.T) {
+		m := cmap.New[int, int](4)
+		m.Store(1, 1)
+
+		m.Range(func(k, v int) bool {
+			// Keys stored here may land in a shard that has not been visited yet.
+			if k < 100 {
+				m.Delete(k)
+				m.Store(k+100, v)
+			}
+			return true
+		})
+		if _, ok := m.Load(101).(maybe.Some[int]); !ok {
+			t.Fatal("modification during Range should be applied")
+		}
+	})
+}
+
+func TestMap_Snapshot(t *testing.T) {
+	t.Run("returns an independent copy", func(t *testing.T) {
+		m := cmap.New[string, int](4)
+		for i := 0; i < 10; i++ {
+			m.Store(strconv.Itoa(i), i)
+		}
+
+		snap := m.Snapshot()
+		m.Store("new", 100)
+		if len(snap) != 10 || snap["5"] != 5 {
+			t.Errorf("unexpected snapshot %v", snap)
+		}
+		if _, ok := snap["new"]; ok {
+			t.Error("snapshot should not observe later writes")
+		}
+	})
+}
+
+func TestMap_Concurrent(t *testing.T) {
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		m := cmap.New[int, int](8)
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				m.Store(i, i)
+				m.Load(i)
+				m.LoadOrCompute(i+1000, func() (int, error) { return i, nil })
+			}(i)
+		}
+		wg.Wait()
+
+		if m.Len() != 200 {
+			t.Errorf("expected 200 keys, got %d", m.Len())
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrMissing is wrapped by the error recorded when a Required key is found in no source.
+var ErrMissing = errors.New("config: missing required key")
+
+// Source supplies raw configuration values by dotted key, such as "db.port".
+//
+// Lookup returns:
+//   - Just(value) if the source defines the key
+//   - None if it does not, so the next source is consulted
+//   - Failure if the source could not be read
+type Source interface {
+	Lookup(key string) maybe.Maybe[string]
+}
+
+// SourceFunc adapts a function to the Source interface.
+type SourceFunc func(key string) maybe.Maybe[string]
+
+// Lookup calls f(key).
+func (f SourceFunc) Lookup(key string) maybe.Maybe[string] {
+	return f(key)
+}
+
+// Env returns a Source that reads environment variables. The key is upper-cased, dots and
+// dashes become underscores, and prefix is prepended: with prefix "APP_", "db.max-conns"
+// reads APP_DB_MAX_CONNS.
+func Env(prefix string) Source {
+	replacer := strings.NewReplacer(".", "_", "-", "_")
+	return SourceFunc(func(key string) maybe.Maybe[string] {
+		if v, ok := os.LookupEnv(prefix + strings.ToUpper(replacer.Replace(key))); ok {
+			return maybe.Just(v)
+		}
+		return maybe.Empty[string]()
+	})
+}
+
+// Values returns a Source backed by a map, typically used for the defaults layer.
+func Values(values map[string]string) Source {
+	return SourceFunc(func(key string) maybe.Maybe[string] {
+		if v, ok := values[key]; ok {
+			return maybe.Just(v)
+		}
+		return maybe.Empty[string]()
+	})
+}
+
+// JSON parses a JSON object into a Source. Nested objects are flattened into dotted keys,
+// null values are treated as absent, and arrays are kept as their JSON text. Anything but
+// whitespace after the object is an error, so a concatenated or truncated file is rejected.
+//
+// Example:
+//
+//	file := config.JSON(data) // {"db": {"port": 5432}} defines "db.port" = "5432"
+func JSON(data []byte) maybe.Maybe[Source] {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var root map[string]any
+	if err := dec.Decode(&root); err != nil {
+		return maybe.Failed[Source](fmt.Errorf("config: parse JSON: %w", err))
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return maybe.Failed[Source](errors.New("config: parse JSON: unexpected data after the top-level object"))
+	}
+
+	flat := map[string]string{}
+	flatten("", root, flat)
+	return maybe.Just(Values(flat))
+}
+
+func flatten(prefix string, node map[string]any, out map[string]string) {
+	for k, v := range node {
+		key := prefix + k
+		switch v := v.(type) {
+		case map[string]any:
+			flatten(key+".", v, out)
+		case nil:
+		case string:
+			out[key] = v
+		case bool:
+			out[key] = strconv.FormatBool(v)
+		case json.Number:
+			out[key] = v.String()
+		default:
+			text, _ := json.Marshal(v)
+			out[key] = string(text)
+		}
+	}
+}
+
+// Resolver layers Sources in priority order: the first source that defines a key wins.
+//
+// Example:
+//
+//	file, err := config.JSON(data).OrError()
+//	r := config.New(config.Env("APP_"), file, config.Values(defaults))
+type Resolver struct {
+	sources []Source
+}
+
+// New creates a Resolver consulting sources from highest to lowest priority.
+func New(sources ...Source) *Resolver {
+	return &Resolver{sources: sources}
+}
+
+// Lookup returns the value of key from the first source that defines it.
+//
+// Behavior:
+//   - Returns the first Some in priority order
+//   - Returns the first Failure encountered (lower sources are not consulted)
+//   - A source that panics or returns a nil Maybe fails the lookup with an error naming it
+//   - Returns None if no source defines key
+func (r *Resolver) Lookup(key string) maybe.Maybe[string] {
+	for i, s := range r.sources {
+		result := maybe.Do(func() maybe.Maybe[string] { return s.Lookup(key) })
+		if result == nil {
+			return maybe.Failed[string](fmt.Errorf("config: source %d (%T) returned a nil Maybe for %q", i, s, key))
+		}
+		if !result.IsNone() {
+			return result
+		}
+	}
+	return maybe.Empty[string]()
+}
+
+// Get looks key up and parses it.
+//
+// Behavior:
+//   - If no source defines key: returns None
+//   - If parsing or the lookup fails, or parse panics: returns Failure naming the key
+//   - Otherwise: returns Just(parsed value)
+//
+// Example:
+//
+//	port := config.Get(r, "http.port", strconv.Atoi)
+func Get[T any](r *Resolver, key string, parse func(string) (T, error)) maybe.Maybe[T] {
+	raw, ok, err := r.Lookup(key).Get()
+	if err == nil && ok {
+		var v T
+		v, err = maybe.Try(func() (T, error) { return parse(raw) }).OrError()
+		if err == nil {
+			return maybe.Just(v)
+		}
+	}
+	if err != nil {
+		return maybe.Failed[T](fmt.Errorf("config: key %q: %w", key, err))
+	}
+	return maybe.Empty[T]()
+}
+
+// Text is the parser for plain string values.
+func Text(s string) (string, error) {
+	return s, nil
+}
+
+// Loader accumulates the errors of every Required and Optional call made while building
+// a config, so that all problems are reported at once instead of one per run.
+type Loader struct {
+	r    *Resolver
+	errs []error
+}
+
+// Load calls build with a Loader and returns the config it produced.
+//
+// Behavior:
+//   - If every lookup succeeded: returns Just(config)
+//   - Otherwise: returns Failure joining every recorded error, sorted by message
+//   - If build panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	cfg := config.Load(r, func(l *config.Loader) Config {
+//	    return Config{
+//	        Host:    config.Required(l, "db.host", config.Text),
+//	        Port:    config.Required(l, "db.port", strconv.Atoi),
+//	        Timeout: config.Optional(l, "db.timeout", time.ParseDuration, 5*time.Second),
+//	    }
+//	})
+//	// Failure listing both "db.host" and "db.port" if neither is set
+func Load[C any](r *Resolver, build func(l *Loader) C) maybe.Maybe[C] {
+	return maybe.Try(func() (C, error) {
+		l := &Loader{r: r}
+		c := build(l)
+		if len(l.errs) > 0 {
+			sort.Slice(l.errs, func(i, j int) bool { return l.errs[i].Error() < l.errs[j].Error() })
+			var zero C
+			return zero, errors.Join(l.errs...)
+		}
+		return c, nil
+	})
+}
+
+// Required returns the parsed value of key, recording an error wrapping ErrMissing when no
+// source defines it, or the parse error when it is invalid.
+func Required[T any](l *Loader, key string, parse func(string) (T, error)) T {
+	v, ok, err := Get(l.r, key, parse).Get()
+	switch {
+	case err != nil:
+		l.errs = append(l.errs, err)
+	case !ok:
+		l.errs = append(l.errs, fmt.Errorf("%w %q", ErrMissing, key))
+	}
+	return v
+}
+
+// Optional returns the parsed value of key, or def when no source defines it.
+// An invalid value is still recorded as an error.
+func Optional[T any](l *Loader, key string, parse func(string) (T, error), def T) T {
+	v, ok, err := Get(l.r, key, parse).Get()
+	switch {
+	case err != nil:
+		l.errs = append(l.errs, err)
+		return def
+	case !ok:
+		return def
+	}
+	return v
+}
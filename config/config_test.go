@@ -0,0 +1,226 @@
+package config_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/config"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type dbConfig struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+}
+
+func loadDB(r *config.Resolver) maybe.Maybe[dbConfig] {
+	return config.Load(r, func(l *config.Loader) dbConfig {
+		return dbConfig{
+			Host:    config.Required(l, "db.host", config.Text),
+			Port:    config.Required(l, "db.port", strconv.Atoi),
+			Timeout: config.Optional(l, "db.timeout", time.ParseDuration, 5*time.Second),
+		}
+	})
+}
+
+func mustJSON(t *testing.T, data string) config.Source {
+	t.Helper()
+	src, err := config.JSON([]byte(data)).OrError()
+	if err != nil {
+		t.Fatalf("unexpected JSON error: %v", err)
+	}
+	return src
+}
+
+func TestResolver_Lookup(t *testing.T) {
+	t.Run("first source defining the key wins", func(t *testing.T) {
+		r := config.New(
+			config.Values(map[string]string{"a": "high"}),
+			config.Values(map[string]string{"a": "low", "b": "low"}),
+		)
+
+		if v, _, _ := r.Lookup("a").Get(); v != "high" {
+			t.Errorf("expected high, got %q", v)
+		}
+		if v, _, _ := r.Lookup("b").Get(); v != "low" {
+			t.Errorf("expected fallback to low, got %q", v)
+		}
+		if _, ok := r.Lookup("c").(maybe.None[string]); !ok {
+			t.Error("expected None for undefined key")
+		}
+	})
+
+	t.Run("stops at a failing source", func(t *testing.T) {
+		srcErr := errors.New("vault sealed")
+		r := config.New(
+			config.SourceFunc(func(string) maybe.Maybe[string] { return maybe.Failed[string](srcErr) }),
+			config.Values(map[string]string{"a": "ignored"}),
+		)
+
+		if _, _, err := r.Lookup("a").Get(); !errors.Is(err, srcErr) {
+			t.Errorf("expected source error, got %v", err)
+		}
+	})
+	t.Run("fails naming a source that returns a nil Maybe", func(t *testing.T) {
+		r := config.New(
+			config.Values(nil),
+			config.SourceFunc(func(string) maybe.Maybe[string] { return nil }),
+			config.Values(map[string]string{"a": "ignored"}),
+		)
+
+		_, _, err := r.Lookup("a").Get()
+		if err == nil || !strings.Contains(err.Error(), "source 1 (config.SourceFunc)") {
+			t.Errorf("expected Failure naming the source, got %v", err)
+		}
+	})
+
+	t.Run("converts a source panic to Failure", func(t *testing.T) {
+		r := config.New(config.SourceFunc(func(string) maybe.Maybe[string] { panic("vault client bug") }))
+
+		if _, _, err := r.Lookup("a").Get(); err == nil || !strings.Contains(err.Error(), "vault client bug") {
+			t.Errorf("expected Failure with the panic, got %v", err)
+		}
+	})
+}
+
+func TestEnv(t *testing.T) {
+	t.Setenv("APP_DB_MAX_CONNS", "12")
+	src := config.Env("APP_")
+
+	if v, _, _ := src.Lookup("db.max-conns").Get(); v != "12" {
+		t.Errorf("expected 12, got %q", v)
+	}
+	if _, ok := src.Lookup("db.unset").(maybe.None[string]); !ok {
+		t.Error("expected None for unset variable")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("flattens nested objects", func(t *testing.T) {
+		src := mustJSON(t, `{"db": {"host": "pg", "port": 5432, "tls": true, "replicas": ["a", "b"], "user": null}}`)
+
+		for key, want := range map[string]string{
+			"db.host":     "pg",
+			"db.port":     "5432",
+			"db.tls":      "true",
+			"db.replicas": `["a","b"]`,
+		} {
+			if v, _, _ := src.Lookup(key).Get(); v != want {
+				t.Errorf("%s: expected %q, got %q", key, want, v)
+			}
+		}
+		if _, ok := src.Lookup("db.user").(maybe.None[string]); !ok {
+			t.Error("expected null to be absent")
+		}
+	})
+
+	t.Run("returns Failure for invalid JSON", func(t *testing.T) {
+		if _, ok := config.JSON([]byte(`{"db":`)).(maybe.Failure[config.Source]); !ok {
+			t.Error("expected Failure")
+		}
+	})
+
+	t.Run("rejects data after the top-level object", func(t *testing.T) {
+		for _, data := range []string{`{"a": 1} {"a": 2}`, `{"a": 1} x`, `{"a": 1}]`} {
+			if !config.JSON([]byte(data)).IsFailed() {
+				t.Errorf("%s: expected Failure", data)
+			}
+		}
+		if !config.JSON([]byte("{\"a\": 1}\n\t ")).IsSome() {
+			t.Error("expected trailing whitespace to be accepted")
+		}
+	})
+}
+
+func TestGet(t *testing.T) {
+	r := config.New(config.Values(map[string]string{"port": "8080", "bad": "x"}))
+
+	t.Run("parses defined key", func(t *testing.T) {
+		if v, _, _ := config.Get(r, "port", strconv.Atoi).Get(); v != 8080 {
+			t.Errorf("expected 8080, got %d", v)
+		}
+	})
+
+	t.Run("returns None for undefined key", func(t *testing.T) {
+		if _, ok := config.Get(r, "missing", strconv.Atoi).(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("returns Failure naming the key", func(t *testing.T) {
+		_, _, err := config.Get(r, "bad", strconv.Atoi).Get()
+		if !errors.Is(err, strconv.ErrSyntax) || !strings.Contains(err.Error(), `"bad"`) {
+			t.Errorf("expected parse error naming key, got %v", err)
+		}
+	})
+
+	t.Run("converts parser panic to Failure naming the key", func(t *testing.T) {
+		panicking := func(string) (int, error) { panic("bad parser") }
+		_, _, err := config.Get(r, "port", panicking).Get()
+		if err == nil || !strings.Contains(err.Error(), `"port"`) {
+			t.Errorf("expected Failure naming key, got %v", err)
+		}
+	})
+
+	t.Run("wraps source failures with the key", func(t *testing.T) {
+		srcErr := errors.New("unreachable")
+		failing := config.New(config.SourceFunc(func(string) maybe.Maybe[string] { return maybe.Failed[string](srcErr) }))
+
+		_, _, err := config.Get(failing, "port", strconv.Atoi).Get()
+		if !errors.Is(err, srcErr) || !strings.Contains(err.Error(), `"port"`) {
+			t.Errorf("expected source error naming key, got %v", err)
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("layers env over file over defaults", func(t *testing.T) {
+		t.Setenv("APP_DB_HOST", "env-host")
+		r := config.New(
+			config.Env("APP_"),
+			mustJSON(t, `{"db": {"host": "file-host", "port": 5432}}`),
+			config.Values(map[string]string{"db.port": "1", "db.timeout": "2s"}),
+		)
+
+		cfg, err := loadDB(r).OrError()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg != (dbConfig{Host: "env-host", Port: 5432, Timeout: 2 * time.Second}) {
+			t.Errorf("unexpected config %+v", cfg)
+		}
+	})
+
+	t.Run("uses Optional default", func(t *testing.T) {
+		r := config.New(config.Values(map[string]string{"db.host": "h", "db.port": "1"}))
+
+		if cfg, _, _ := loadDB(r).Get(); cfg.Timeout != 5*time.Second {
+			t.Errorf("expected default timeout, got %s", cfg.Timeout)
+		}
+	})
+
+	t.Run("accumulates every error", func(t *testing.T) {
+		r := config.New(config.Values(map[string]string{"db.port": "abc", "db.timeout": "soon"}))
+
+		_, _, err := loadDB(r).Get()
+		if !errors.Is(err, config.ErrMissing) || !errors.Is(err, strconv.ErrSyntax) {
+			t.Fatalf("expected missing and syntax errors, got %v", err)
+		}
+		for _, key := range []string{`"db.host"`, `"db.port"`, `"db.timeout"`} {
+			if !strings.Contains(err.Error(), key) {
+				t.Errorf("expected error to mention %s, got %v", key, err)
+			}
+		}
+	})
+
+	t.Run("converts build panic to Failure", func(t *testing.T) {
+		result := config.Load(config.New(), func(l *config.Loader) int { panic("bad build") })
+		if _, ok := result.(maybe.Failure[int]); !ok {
+			t.Error("expected Failure")
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package dag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrSkipped is wrapped by the Failure of a node that did not run because one of its
+// dependencies did not succeed.
+var ErrSkipped = errors.New("skipped")
+
+// Func computes a node's value. It receives the values of the node's dependencies keyed by name.
+type Func[T any] func(ctx context.Context, deps map[string]T) (T, error)
+
+// Graph is a set of named nodes with dependencies between them.
+// Run executes independent nodes concurrently, each as soon as its dependencies succeed.
+//
+// Example:
+//
+//	g := dag.New[any]().
+//	    Add("user", fetchUser).
+//	    Add("orders", fetchOrders, "user").
+//	    Add("prefs", fetchPrefs, "user").
+//	    Add("page", renderPage, "orders", "prefs")
+//
+//	results := g.Run(ctx) // Just(map[string]Maybe[any]{...}) or Failed(invalid graph)
+type Graph[T any] struct {
+	nodes map[string]*node[T]
+	order []string
+	err   error
+}
+
+type node[T any] struct {
+	fn   Func[T]
+	deps []string
+}
+
+// New creates an empty Graph.
+func New[T any]() *Graph[T] {
+	return &Graph[T]{nodes: map[string]*node[T]{}}
+}
+
+// Add registers a node named name that depends on the nodes named in deps,
+// and returns the Graph for chaining. Adding a name twice makes Run fail.
+func (g *Graph[T]) Add(name string, fn Func[T], deps ...string) *Graph[T] {
+	if _, exists := g.nodes[name]; exists {
+		g.err = errors.Join(g.err, fmt.Errorf("dag: duplicate node %q", name))
+		return g
+	}
+	g.nodes[name] = &node[T]{fn: fn, deps: deps}
+	g.order = append(g.order, name)
+	return g
+}
+
+// Run executes every node and returns each node's result keyed by name.
+//
+// Behavior:
+//   - If the graph is invalid (duplicate node, unknown dependency, or cycle): returns Failure (nothing runs)
+//   - Otherwise returns Just(results), where each result is:
+//   - Just(value) if the node ran and succeeded
+//   - Failure(err) if the node returned an error or panicked
+//   - Failure wrapping ErrSkipped if a dependency did not succeed
+//   - Failure(ctx.Err()) if ctx was done before the node started
+//
+// Example:
+//
+//	results, err := g.Run(ctx).OrError()
+//	page, err := results["page"].OrError()
+func (g *Graph[T]) Run(ctx context.Context) maybe.Maybe[map[string]maybe.Maybe[T]] {
+	if err := g.validate(); err != nil {
+		return maybe.Failed[map[string]maybe.Maybe[T]](err)
+	}
+
+	done := make(map[string]chan struct{}, len(g.nodes))
+	for name := range g.nodes {
+		done[name] = make(chan struct{})
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]maybe.Maybe[T], len(g.nodes))
+
+	for _, name := range g.order {
+		wg.Add(1)
+		go func(name string, n *node[T]) {
+			defer wg.Done()
+			defer close(done[name])
+
+			deps := make(map[string]T, len(n.deps))
+			for _, dep := range n.deps {
+				<-done[dep]
+				mu.Lock()
+				v, ok, _ := results[dep].Get()
+				mu.Unlock()
+				if !ok {
+					mu.Lock()
+					results[name] = maybe.Failed[T](fmt.Errorf("dag: node %q %w: dependency %q did not succeed", name, ErrSkipped, dep))
+					mu.Unlock()
+					return
+				}
+				deps[dep] = v
+			}
+
+			result := maybe.Try(func() (T, error) {
+				if err := ctx.Err(); err != nil {
+					var zero T
+					return zero, err
+				}
+				return n.fn(ctx, deps)
+			})
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}(name, g.nodes[name])
+	}
+
+	wg.Wait()
+	return maybe.Just(results)
+}
+
+// validate reports duplicate nodes, unknown dependencies, and cycles.
+func (g *Graph[T]) validate() error {
+	if g.err != nil {
+		return g.err
+	}
+
+	indegree := make(map[string]int, len(g.nodes))
+	dependents := make(map[string][]string, len(g.nodes))
+	for _, name := range g.order {
+		for _, dep := range g.nodes[name].deps {
+			if _, ok := g.nodes[dep]; !ok {
+				return fmt.Errorf("dag: node %q depends on unknown node %q", name, dep)
+			}
+			indegree[name]++
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	var ready []string
+	for _, name := range g.order {
+		if indegree[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+	visited := 0
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+		visited++
+		for _, dependent := range dependents[name] {
+			indegree[dependent]--
+			if indegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if visited != len(g.nodes) {
+		return errors.New("dag: graph contains a cycle")
+	}
+	return nil
+}
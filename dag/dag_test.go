@@ -0,0 +1,174 @@
+package dag_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/dag"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func constant(v int) dag.Func[int] {
+	return func(ctx context.Context, deps map[string]int) (int, error) {
+		return v, nil
+	}
+}
+
+func sum(ctx context.Context, deps map[string]int) (int, error) {
+	total := 0
+	for _, v := range deps {
+		total += v
+	}
+	return total, nil
+}
+
+func run(t *testing.T, g *dag.Graph[int]) map[string]maybe.Maybe[int] {
+	t.Helper()
+	results, err := g.Run(context.Background()).OrError()
+	if err != nil {
+		t.Fatalf("unexpected graph error: %v", err)
+	}
+	return results
+}
+
+func TestGraph_Run(t *testing.T) {
+	t.Run("passes dependency values to dependents", func(t *testing.T) {
+		results := run(t, dag.New[int]().
+			Add("a", constant(1)).
+			Add("b", constant(2)).
+			Add("c", sum, "a", "b").
+			Add("d", sum, "c", "a"))
+
+		for name, want := range map[string]int{"a": 1, "b": 2, "c": 3, "d": 4} {
+			if v, ok, _ := results[name].Get(); !ok || v != want {
+				t.Errorf("node %s: expected %d, got %d (ok=%v)", name, want, v, ok)
+			}
+		}
+	})
+
+	t.Run("runs independent nodes concurrently", func(t *testing.T) {
+		var mu sync.Mutex
+		running, peak := 0, 0
+		slow := func(ctx context.Context, deps map[string]int) (int, error) {
+			mu.Lock()
+			running++
+			if running > peak {
+				peak = running
+			}
+			mu.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return 0, nil
+		}
+
+		run(t, dag.New[int]().Add("a", slow).Add("b", slow).Add("c", slow))
+		if peak < 2 {
+			t.Errorf("expected independent nodes to overlap, peak concurrency %d", peak)
+		}
+	})
+
+	t.Run("runs dependents after their dependencies", func(t *testing.T) {
+		var mu sync.Mutex
+		var order []string
+		record := func(name string) dag.Func[int] {
+			return func(ctx context.Context, deps map[string]int) (int, error) {
+				mu.Lock()
+				order = append(order, name)
+				mu.Unlock()
+				return 0, nil
+			}
+		}
+
+		run(t, dag.New[int]().Add("last", record("last"), "first").Add("first", record("first")))
+		if strings.Join(order, ",") != "first,last" {
+			t.Errorf("expected first,last, got %v", order)
+		}
+	})
+
+	t.Run("skips dependents of failed nodes", func(t *testing.T) {
+		nodeErr := errors.New("boom")
+		called := false
+		results := run(t, dag.New[int]().
+			Add("a", func(ctx context.Context, deps map[string]int) (int, error) { return 0, nodeErr }).
+			Add("b", func(ctx context.Context, deps map[string]int) (int, error) { called = true; return 0, nil }, "a").
+			Add("c", sum, "b").
+			Add("d", constant(1)))
+
+		if _, _, err := results["a"].Get(); !errors.Is(err, nodeErr) {
+			t.Errorf("expected node error for a, got %v", err)
+		}
+		for _, name := range []string{"b", "c"} {
+			if _, _, err := results[name].Get(); !errors.Is(err, dag.ErrSkipped) {
+				t.Errorf("expected %s to be skipped, got %v", name, err)
+			}
+		}
+		if called {
+			t.Error("dependent of a failed node should not run")
+		}
+		if _, ok := results["d"].(maybe.Some[int]); !ok {
+			t.Error("independent node should still succeed")
+		}
+	})
+
+	t.Run("converts node panic to Failure", func(t *testing.T) {
+		results := run(t, dag.New[int]().
+			Add("a", func(ctx context.Context, deps map[string]int) (int, error) { panic("node panic") }))
+
+		if _, ok := results["a"].(maybe.Failure[int]); !ok {
+			t.Fatal("expected Failure when a node panics")
+		}
+	})
+
+	t.Run("fails nodes with context error when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		results, _, _ := dag.New[int]().Add("a", constant(1)).Run(ctx).Get()
+		if _, _, err := results["a"].Get(); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("returns empty results for empty graph", func(t *testing.T) {
+		if results := run(t, dag.New[int]()); len(results) != 0 {
+			t.Errorf("expected no results, got %v", results)
+		}
+	})
+}
+
+func TestGraph_Validation(t *testing.T) {
+	t.Run("rejects duplicate nodes", func(t *testing.T) {
+		result := dag.New[int]().Add("a", constant(1)).Add("a", constant(2)).Run(context.Background())
+
+		if _, _, err := result.Get(); err == nil || !strings.Contains(err.Error(), "duplicate") {
+			t.Errorf("expected duplicate node error, got %v", err)
+		}
+	})
+
+	t.Run("rejects unknown dependencies", func(t *testing.T) {
+		result := dag.New[int]().Add("a", sum, "missing").Run(context.Background())
+
+		if _, _, err := result.Get(); err == nil || !strings.Contains(err.Error(), "unknown node") {
+			t.Errorf("expected unknown dependency error, got %v", err)
+		}
+	})
+
+	t.Run("rejects cycles without running anything", func(t *testing.T) {
+		called := false
+		fn := func(ctx context.Context, deps map[string]int) (int, error) { called = true; return 0, nil }
+		result := dag.New[int]().Add("root", fn).Add("a", fn, "b").Add("b", fn, "a").Run(context.Background())
+
+		if _, _, err := result.Get(); err == nil || !strings.Contains(err.Error(), "cycle") {
+			t.Errorf("expected cycle error, got %v", err)
+		}
+		if called {
+			t.Error("no node should run for an invalid graph")
+		}
+	})
+}
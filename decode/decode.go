@@ -0,0 +1,40 @@
+package decode
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrNoMatch is wrapped by the Failure FirstOf returns when no decoder accepts the input.
+var ErrNoMatch = errors.New("decode: no decoder accepted the input")
+
+// FirstOf returns a decoder that tries each decoder in order and keeps the first success,
+// for consuming messages whose schema has evolved over several versions. List the newest
+// version first so current messages decode on the first attempt.
+//
+// Behavior of the returned function:
+//   - If a decoder returns (value, nil): returns Just(value); later decoders are not tried
+//   - If a decoder returns an error or panics: tries the next one
+//   - If every decoder fails (or there are none): returns Failure wrapping ErrNoMatch and
+//     every decoder's error, each prefixed with its position
+//
+// Example:
+//
+//	decodeOrder := decode.FirstOf(decodeOrderV3, decodeOrderV2, decodeOrderV1)
+//
+//	order := maybe.FlatMap(msg, decodeOrder) // errors.Is(err, decode.ErrNoMatch) if none fit
+func FirstOf[T any](decoders ...func([]byte) (T, error)) func([]byte) maybe.Maybe[T] {
+	return func(data []byte) maybe.Maybe[T] {
+		errs := make([]error, 0, len(decoders))
+		for i, decoder := range decoders {
+			v, err := maybe.Try(func() (T, error) { return decoder(data) }).OrError()
+			if err == nil {
+				return maybe.Just(v)
+			}
+			errs = append(errs, fmt.Errorf("decoder %d: %w", i, err))
+		}
+		return maybe.Failed[T](fmt.Errorf("%w: %w", ErrNoMatch, errors.Join(errs...)))
+	}
+}
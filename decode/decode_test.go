@@ -0,0 +1,98 @@
+package decode_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/decode"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type order struct {
+	ID    string
+	Total int
+}
+
+var errWrongVersion = errors.New("wrong version")
+
+func decodeV2(data []byte) (order, error) {
+	var msg struct {
+		Version int    `json:"v"`
+		ID      string `json:"id"`
+		Cents   int    `json:"total_cents"`
+	}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return order{}, err
+	}
+	if msg.Version != 2 {
+		return order{}, errWrongVersion
+	}
+	return order{ID: msg.ID, Total: msg.Cents}, nil
+}
+
+func decodeV1(data []byte) (order, error) {
+	var msg struct {
+		ID    string `json:"order_id"`
+		Total int    `json:"total"`
+	}
+	err := json.Unmarshal(data, &msg)
+	if err == nil && msg.ID == "" {
+		err = errWrongVersion
+	}
+	return order{ID: msg.ID, Total: msg.Total * 100}, err
+}
+
+func TestFirstOf(t *testing.T) {
+	decodeOrder := decode.FirstOf(decodeV2, decodeV1)
+
+	t.Run("uses first decoder that succeeds", func(t *testing.T) {
+		v2 := decodeOrder([]byte(`{"v":2,"id":"a","total_cents":150}`)).OrPanic()
+		v1 := decodeOrder([]byte(`{"order_id":"b","total":3}`)).OrPanic()
+		if v2 != (order{ID: "a", Total: 150}) || v1 != (order{ID: "b", Total: 300}) {
+			t.Errorf("unexpected results %+v %+v", v2, v1)
+		}
+	})
+
+	t.Run("stops after first success", func(t *testing.T) {
+		calls := 0
+		counting := func([]byte) (order, error) { calls++; return order{}, nil }
+		decode.FirstOf(decodeV2, counting, counting)([]byte(`{"order_id":"b"}`))
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("aggregates every error on total failure", func(t *testing.T) {
+		_, _, err := decodeOrder([]byte(`not json`)).Get()
+		if !errors.Is(err, decode.ErrNoMatch) {
+			t.Fatalf("expected ErrNoMatch, got %v", err)
+		}
+		var syntax *json.SyntaxError
+		if !errors.As(err, &syntax) || !strings.Contains(err.Error(), "decoder 0") || !strings.Contains(err.Error(), "decoder 1") {
+			t.Errorf("expected both decoder errors, got %v", err)
+		}
+	})
+
+	t.Run("treats decoder panic as failure", func(t *testing.T) {
+		panicking := func([]byte) (order, error) { panic("bad decoder") }
+		got := decode.FirstOf(panicking, decodeV1)([]byte(`{"order_id":"c"}`))
+		if v, ok, _ := got.Get(); !ok || v.ID != "c" {
+			t.Errorf("expected fallback decoder result, got %v", got)
+		}
+	})
+
+	t.Run("fails without decoders", func(t *testing.T) {
+		if !decode.FirstOf[order]()(nil).ErrIs(decode.ErrNoMatch) {
+			t.Error("expected ErrNoMatch")
+		}
+	})
+
+	t.Run("works with FlatMap", func(t *testing.T) {
+		got := maybe.FlatMap(maybe.Just([]byte(`{"order_id":"d","total":1}`)), decodeOrder)
+		if v, ok, _ := got.Get(); !ok || v.ID != "d" {
+			t.Errorf("unexpected result %v", got)
+		}
+	})
+}
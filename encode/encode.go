@@ -0,0 +1,94 @@
+package encode
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Base64Encode returns the standard base64 encoding of data. Encoding cannot fail,
+// so it can be passed to maybe.Map as is.
+//
+// Example:
+//
+//	payload := maybe.Map(maybe.Map(report, render), encode.Base64Encode)
+func Base64Encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// Base64Decode decodes standard base64 text. It changes the type from string to []byte,
+// so it is a step for maybe.FlatMap rather than Some.FlatMap.
+//
+// Behavior:
+//   - If s is valid base64: returns Just(decoded bytes)
+//   - Otherwise: returns Failure with the base64.CorruptInputError
+//
+// Example:
+//
+//	raw := maybe.FlatMap(field, encode.Base64Decode)
+func Base64Decode(s string) maybe.Maybe[[]byte] {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return maybe.Failed[[]byte](err)
+	}
+	return maybe.Just(data)
+}
+
+// Gzip compresses data with gzip at the default compression level.
+// Compressing into memory cannot fail, so it fits maybe.Map and Some.Map directly.
+//
+// Example:
+//
+//	body := maybe.Map(export, encode.Gzip)
+func Gzip(data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(data) // writes to a bytes.Buffer do not fail
+	w.Close()
+	return buf.Bytes()
+}
+
+// Gunzip decompresses gzip data. Its signature fits FlatMap directly.
+//
+// Behavior:
+//   - If data is a valid gzip stream: returns Just(decompressed bytes)
+//   - If the header is invalid, the stream is truncated or the checksum does not match:
+//     returns Failure with the error
+//
+// Example:
+//
+//	records := maybe.FlatMap(maybe.FlatMap(upload, encode.Gunzip), parseRecords)
+func Gunzip(data []byte) maybe.Maybe[[]byte] {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return maybe.Failed[[]byte](err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return maybe.Failed[[]byte](err)
+	}
+	return maybe.Just(out)
+}
+
+// GzipReader wraps r in a gzip decompressing reader, for inputs too large to load at once.
+// The header is read eagerly, so a stream that is not gzip at all fails here rather than on
+// the first Read. Errors later in the stream surface from Read as usual.
+//
+// Behavior:
+//   - If the gzip header is valid: returns Just(reader); the caller should Close it
+//   - Otherwise: returns Failure with the header error
+//
+// Example:
+//
+//	lines := maybe.FlatMap(encode.GzipReader(resp.Body), func(r *gzip.Reader) maybe.Maybe[int] {
+//	    defer r.Close()
+//	    return countLines(r)
+//	})
+func GzipReader(r io.Reader) maybe.Maybe[*gzip.Reader] {
+	return maybe.Try(func() (*gzip.Reader, error) {
+		return gzip.NewReader(r)
+	})
+}
@@ -0,0 +1,80 @@
+package encode_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/encode"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestBase64(t *testing.T) {
+	t.Run("round-trips data", func(t *testing.T) {
+		got := maybe.FlatMap(maybe.Map(maybe.Just([]byte("hello, world")), encode.Base64Encode), encode.Base64Decode)
+		if string(got.OrPanic()) != "hello, world" {
+			t.Errorf("unexpected round trip %q", got.OrPanic())
+		}
+	})
+
+	t.Run("encodes with standard alphabet", func(t *testing.T) {
+		if got := encode.Base64Encode([]byte("hi?")); got != "aGk/" {
+			t.Errorf("unexpected encoding %s", got)
+		}
+	})
+
+	t.Run("returns Failure on malformed input", func(t *testing.T) {
+		_, _, err := encode.Base64Decode("not base64!").Get()
+		var corrupt base64.CorruptInputError
+		if !errors.As(err, &corrupt) {
+			t.Errorf("expected CorruptInputError, got %v", err)
+		}
+	})
+}
+
+func TestGzip(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible "), 100)
+
+	t.Run("round-trips data", func(t *testing.T) {
+		compressed := encode.Gzip(data)
+		if len(compressed) >= len(data) {
+			t.Errorf("expected compression, got %d bytes from %d", len(compressed), len(data))
+		}
+		if got := encode.Gunzip(compressed).OrPanic(); !bytes.Equal(got, data) {
+			t.Error("round trip mismatch")
+		}
+	})
+
+	t.Run("returns Failure for non-gzip data", func(t *testing.T) {
+		if !encode.Gunzip([]byte("plain text")).ErrIs(gzip.ErrHeader) {
+			t.Error("expected ErrHeader")
+		}
+	})
+
+	t.Run("returns Failure for truncated stream", func(t *testing.T) {
+		compressed := encode.Gzip(data)
+		if !encode.Gunzip(compressed[:len(compressed)-4]).ErrIs(io.ErrUnexpectedEOF) {
+			t.Error("expected ErrUnexpectedEOF")
+		}
+	})
+}
+
+func TestGzipReader(t *testing.T) {
+	t.Run("decompresses lazily", func(t *testing.T) {
+		r := encode.GzipReader(bytes.NewReader(encode.Gzip([]byte("streamed")))).OrPanic()
+		defer r.Close()
+		got, err := io.ReadAll(r)
+		if err != nil || string(got) != "streamed" {
+			t.Errorf("unexpected result %q %v", got, err)
+		}
+	})
+
+	t.Run("returns Failure for invalid header", func(t *testing.T) {
+		if !encode.GzipReader(bytes.NewReader([]byte("nope"))).ErrIs(io.ErrUnexpectedEOF) {
+			t.Error("expected header error")
+		}
+	})
+}
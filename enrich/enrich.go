@@ -0,0 +1,32 @@
+package enrich
+
+import "github.com/lonelywolflee/lw-project-fp-go/maybe"
+
+// With enriches the value in m with the result of an optional lookup. The lookup result is
+// merged in only when it is Some; when the lookup finds nothing or fails, the value passes
+// through unchanged, so a missing or unavailable enrichment source never fails the chain.
+//
+// Behavior:
+//   - If m is None or Failure: returns m unchanged (lookup not called)
+//   - If lookup returns Some(p): returns Just(merge(v, p))
+//   - If lookup returns None or Failure: returns Just(v)
+//   - If lookup or merge panics: returns Failure with the panic converted to an error
+//
+// Use Maybe.MapSoft instead when failed lookups should be reported rather than ignored.
+//
+// Example:
+//
+//	order := enrich.With(loadOrder(id), func(o Order) maybe.Maybe[Geo] {
+//	    return geo.Lookup(o.ShippingIP)
+//	}, func(o Order, g Geo) Order {
+//	    o.Region = g.Region
+//	    return o
+//	}) // the order, with Region set when the lookup succeeded
+func With[T, P any](m maybe.Maybe[T], lookup func(T) maybe.Maybe[P], merge func(T, P) T) maybe.Maybe[T] {
+	return maybe.Map(m, func(v T) T {
+		if p, ok, _ := lookup(v).Get(); ok {
+			return merge(v, p)
+		}
+		return v
+	})
+}
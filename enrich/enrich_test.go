@@ -0,0 +1,66 @@
+package enrich_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/enrich"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type order struct {
+	ID     int
+	Region string
+}
+
+func setRegion(o order, region string) order {
+	o.Region = region
+	return o
+}
+
+func TestWith(t *testing.T) {
+	t.Run("merges lookup result when Some", func(t *testing.T) {
+		got := enrich.With(maybe.Just(order{ID: 1}), func(order) maybe.Maybe[string] {
+			return maybe.Just("eu")
+		}, setRegion).OrPanic()
+		if got.ID != 1 || got.Region != "eu" {
+			t.Errorf("expected enriched order, got %+v", got)
+		}
+	})
+
+	t.Run("keeps value when lookup is None or Failure", func(t *testing.T) {
+		for name, result := range map[string]maybe.Maybe[string]{
+			"none":    maybe.Empty[string](),
+			"failure": maybe.Failed[string](errors.New("geo down")),
+		} {
+			got := enrich.With(maybe.Just(order{ID: 1}), func(order) maybe.Maybe[string] {
+				return result
+			}, func(order, string) order {
+				t.Errorf("%s: merge should not be called", name)
+				return order{}
+			})
+			if v, ok, err := got.Get(); !ok || err != nil || v.ID != 1 || v.Region != "" {
+				t.Errorf("%s: expected original order, got %v", name, got)
+			}
+		}
+	})
+
+	t.Run("propagates None and Failure without lookup", func(t *testing.T) {
+		lookup := func(order) maybe.Maybe[string] { t.Error("lookup should not be called"); return maybe.Empty[string]() }
+		err := errors.New("load failed")
+
+		if !enrich.With(maybe.Empty[order](), lookup, setRegion).IsNone() {
+			t.Error("expected None")
+		}
+		if !enrich.With(maybe.Failed[order](err), lookup, setRegion).ErrIs(err) {
+			t.Error("expected original Failure")
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		got := enrich.With(maybe.Just(order{}), func(order) maybe.Maybe[string] { panic("boom") }, setRegion)
+		if !got.IsFailed() {
+			t.Errorf("expected Failure, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package eventfp
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// EventError reports which event could not be applied during a replay.
+// It wraps the error returned (or panic raised) by the apply function.
+type EventError struct {
+	Index int
+	Err   error
+}
+
+func (e *EventError) Error() string {
+	return fmt.Sprintf("event %d: %v", e.Index, e.Err)
+}
+
+func (e *EventError) Unwrap() error {
+	return e.Err
+}
+
+// Replay folds events into state, starting from initial, to reconstruct an aggregate.
+//
+// Behavior:
+//   - If every event applies: returns Just(final state)
+//   - If apply returns an error or panics: returns Failure with an *EventError
+//     carrying the index of the bad event (later events are not applied)
+//
+// Example:
+//
+//	account := eventfp.Replay(Account{}, events, func(a Account, e Event) (Account, error) {
+//	    switch e.Type {
+//	    case "deposited":
+//	        a.Balance += e.Amount
+//	    case "withdrawn":
+//	        if a.Balance < e.Amount {
+//	            return a, errors.New("overdraft")
+//	        }
+//	        a.Balance -= e.Amount
+//	    default:
+//	        return a, fmt.Errorf("unknown event %q", e.Type)
+//	    }
+//	    return a, nil
+//	}) // Just(account) or Failed[Account](&EventError{Index: 3, ...})
+func Replay[S, E any](initial S, events []E, apply func(S, E) (S, error)) maybe.Maybe[S] {
+	return ReplaySeq(initial, slices.Values(events), apply)
+}
+
+// ReplaySeq is the streaming variant of Replay: it consumes events lazily from a sequence,
+// so large event logs can be folded without loading them into memory.
+// Iteration stops at the first event that fails to apply.
+//
+// Example:
+//
+//	state := eventfp.ReplaySeq(Account{}, store.Events(ctx, accountID), applyEvent)
+func ReplaySeq[S, E any](initial S, events iter.Seq[E], apply func(S, E) (S, error)) maybe.Maybe[S] {
+	state := initial
+	index := 0
+	for e := range events {
+		next, err := maybe.Try(func() (S, error) {
+			return apply(state, e)
+		}).OrError()
+		if err != nil {
+			return maybe.Failed[S](&EventError{Index: index, Err: err})
+		}
+		state = next
+		index++
+	}
+	return maybe.Just(state)
+}
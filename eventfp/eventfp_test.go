@@ -0,0 +1,111 @@
+package eventfp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/eventfp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type event struct {
+	kind   string
+	amount int
+}
+
+var errOverdraft = errors.New("overdraft")
+
+func apply(balance int, e event) (int, error) {
+	switch e.kind {
+	case "deposit":
+		return balance + e.amount, nil
+	case "withdraw":
+		if balance < e.amount {
+			return balance, errOverdraft
+		}
+		return balance - e.amount, nil
+	}
+	panic("unknown event " + e.kind)
+}
+
+func TestReplay(t *testing.T) {
+	t.Run("folds events into state", func(t *testing.T) {
+		events := []event{{"deposit", 100}, {"withdraw", 30}, {"deposit", 5}}
+
+		value, ok, err := eventfp.Replay(0, events, apply).Get()
+		if err != nil || !ok || value != 75 {
+			t.Errorf("expected Just(75), got %d, %v, %v", value, ok, err)
+		}
+	})
+
+	t.Run("returns initial state for no events", func(t *testing.T) {
+		if value, _, _ := eventfp.Replay(10, nil, apply).Get(); value != 10 {
+			t.Errorf("expected 10, got %d", value)
+		}
+	})
+
+	t.Run("reports the index of the first bad event", func(t *testing.T) {
+		events := []event{{"deposit", 10}, {"withdraw", 50}, {"deposit", 100}}
+
+		_, _, err := eventfp.Replay(0, events, apply).Get()
+		var eventErr *eventfp.EventError
+		if !errors.As(err, &eventErr) {
+			t.Fatalf("expected *EventError, got %v", err)
+		}
+		if eventErr.Index != 1 {
+			t.Errorf("expected index 1, got %d", eventErr.Index)
+		}
+		if !errors.Is(err, errOverdraft) {
+			t.Errorf("expected to wrap overdraft error, got %v", err)
+		}
+		if err.Error() != "event 1: overdraft" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	})
+
+	t.Run("converts apply panic to Failure with index", func(t *testing.T) {
+		events := []event{{"deposit", 10}, {"bogus", 0}}
+
+		_, _, err := eventfp.Replay(0, events, apply).Get()
+		var eventErr *eventfp.EventError
+		if !errors.As(err, &eventErr) || eventErr.Index != 1 {
+			t.Fatalf("expected *EventError at index 1, got %v", err)
+		}
+	})
+}
+
+func TestReplaySeq(t *testing.T) {
+	t.Run("folds a lazy sequence", func(t *testing.T) {
+		seq := func(yield func(event) bool) {
+			for i := 0; i < 5; i++ {
+				if !yield(event{"deposit", 1}) {
+					return
+				}
+			}
+		}
+
+		if value, _, _ := eventfp.ReplaySeq(0, seq, apply).Get(); value != 5 {
+			t.Errorf("expected 5, got %d", value)
+		}
+	})
+
+	t.Run("stops consuming after the first bad event", func(t *testing.T) {
+		produced := 0
+		seq := func(yield func(event) bool) {
+			for _, e := range []event{{"deposit", 1}, {"withdraw", 10}, {"deposit", 1}} {
+				produced++
+				if !yield(e) {
+					return
+				}
+			}
+		}
+
+		result := eventfp.ReplaySeq(0, seq, apply)
+		if _, ok := result.(maybe.Failure[int]); !ok {
+			t.Fatal("expected Failure")
+		}
+		if produced != 2 {
+			t.Errorf("expected iteration to stop after 2 events, produced %d", produced)
+		}
+	})
+}
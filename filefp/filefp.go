@@ -0,0 +1,90 @@
+package filefp
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// AtomicWrite writes path through fn so that readers see either the old content or the
+// complete new content, never a partial write. fn writes to a temporary file in the same
+// directory, which is synced and renamed over path only if fn succeeds.
+// The new file has mode 0600, as created by os.CreateTemp.
+//
+// Behavior:
+//   - If fn returns nil: syncs, closes and renames the temporary file, returning Just(struct{}{})
+//     or Failure if any of those steps fails
+//   - If fn returns an error: removes the temporary file and returns Failure with the error
+//   - If fn panics: removes the temporary file and returns Failure with the panic converted to an error
+//   - In every failure case path is left untouched
+//
+// Example:
+//
+//	saved := filefp.AtomicWrite("config.json", func(w io.Writer) error {
+//	    return json.NewEncoder(w).Encode(cfg)
+//	}) // config.json is either the old or the new config, even if the process dies mid-write
+func AtomicWrite(path string, fn func(w io.Writer) error) maybe.Maybe[struct{}] {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return maybe.Failed[struct{}](err)
+	}
+
+	_, err = maybe.Try(func() (struct{}, error) {
+		return struct{}{}, fn(f)
+	}).OrError()
+	if err == nil {
+		err = errors.Join(f.Sync(), f.Close())
+	} else {
+		f.Close()
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return maybe.Failed[struct{}](err)
+	}
+	return maybe.Just(struct{}{})
+}
+
+// WithTempFile creates a temporary file in the default temporary directory, passes it to fn
+// and removes it after fn returns, whether fn succeeds, fails or panics.
+//
+// Behavior:
+//   - If the file cannot be created: returns Failure with that error (fn not called)
+//   - If fn returns (value, nil): returns Just(value)
+//   - If fn returns an error: returns Failure with the error
+//   - If fn panics: returns Failure with the panic converted to an error
+//   - If removing the file fails, its error is joined with the result
+//     (a file that fn already removed is not an error)
+//
+// Example:
+//
+//	digest := filefp.WithTempFile(func(f *os.File) (string, error) {
+//	    if _, err := io.Copy(f, upload); err != nil {
+//	        return "", err
+//	    }
+//	    return scan(f.Name())
+//	})
+func WithTempFile[T any](fn func(f *os.File) (T, error)) maybe.Maybe[T] {
+	f, err := os.CreateTemp("", "filefp-*")
+	if err != nil {
+		return maybe.Failed[T](err)
+	}
+
+	v, err := maybe.Try(func() (T, error) {
+		return fn(f)
+	}).OrError()
+	f.Close()
+	if rmErr := os.Remove(f.Name()); rmErr != nil && !errors.Is(rmErr, fs.ErrNotExist) {
+		err = errors.Join(err, rmErr)
+	}
+	if err != nil {
+		return maybe.Failed[T](err)
+	}
+	return maybe.Just(v)
+}
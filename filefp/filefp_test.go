@@ -0,0 +1,207 @@
+package filefp_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/filefp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return string(data)
+}
+
+func dirEntries(t *testing.T, dir string) int {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	return len(entries)
+}
+
+func TestAtomicWrite(t *testing.T) {
+	t.Run("replaces file content", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "config.json")
+		os.WriteFile(path, []byte("old"), 0o644)
+
+		result := filefp.AtomicWrite(path, func(w io.Writer) error {
+			_, err := io.WriteString(w, "new")
+			return err
+		})
+
+		if _, ok := result.(maybe.Some[struct{}]); !ok {
+			t.Fatalf("expected Some, got %v", result)
+		}
+		if got := readFile(t, path); got != "new" {
+			t.Errorf("expected new content, got %q", got)
+		}
+		if n := dirEntries(t, dir); n != 1 {
+			t.Errorf("expected only the target file, got %d entries", n)
+		}
+	})
+
+	t.Run("leaves file untouched when fn fails", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "data")
+		os.WriteFile(path, []byte("old"), 0o644)
+		errWrite := errors.New("encode failed")
+
+		result := filefp.AtomicWrite(path, func(w io.Writer) error {
+			io.WriteString(w, "partial")
+			return errWrite
+		})
+
+		if !result.ErrIs(errWrite) {
+			t.Errorf("expected fn error, got %v", result)
+		}
+		if got := readFile(t, path); got != "old" {
+			t.Errorf("expected old content, got %q", got)
+		}
+		if n := dirEntries(t, dir); n != 1 {
+			t.Errorf("expected temporary file removed, got %d entries", n)
+		}
+	})
+
+	t.Run("leaves file untouched when fn panics", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "data")
+
+		result := filefp.AtomicWrite(path, func(w io.Writer) error {
+			panic("boom")
+		})
+
+		if _, ok := result.(maybe.Failure[struct{}]); !ok {
+			t.Errorf("expected Failure, got %v", result)
+		}
+		if n := dirEntries(t, dir); n != 0 {
+			t.Errorf("expected no files, got %d entries", n)
+		}
+	})
+
+	t.Run("returns Failure when sync fails", func(t *testing.T) {
+		dir := t.TempDir()
+		result := filefp.AtomicWrite(filepath.Join(dir, "data"), func(w io.Writer) error {
+			return w.(*os.File).Close()
+		})
+
+		if !result.ErrIs(os.ErrClosed) {
+			t.Errorf("expected ErrClosed, got %v", result)
+		}
+		if n := dirEntries(t, dir); n != 0 {
+			t.Errorf("expected temporary file removed, got %d entries", n)
+		}
+	})
+
+	t.Run("returns Failure when rename fails", func(t *testing.T) {
+		dir := t.TempDir()
+		target := filepath.Join(dir, "occupied")
+		os.Mkdir(target, 0o755)
+		os.WriteFile(filepath.Join(target, "child"), nil, 0o644)
+
+		result := filefp.AtomicWrite(target, func(w io.Writer) error { return nil })
+
+		if _, ok := result.(maybe.Failure[struct{}]); !ok {
+			t.Errorf("expected Failure, got %v", result)
+		}
+		if n := dirEntries(t, dir); n != 1 {
+			t.Errorf("expected temporary file removed, got %d entries", n)
+		}
+	})
+
+	t.Run("returns Failure when directory does not exist", func(t *testing.T) {
+		called := false
+		result := filefp.AtomicWrite(filepath.Join(t.TempDir(), "missing", "data"), func(w io.Writer) error {
+			called = true
+			return nil
+		})
+
+		if !result.ErrIs(fs.ErrNotExist) || called {
+			t.Errorf("expected ErrNotExist without calling fn, got %v", result)
+		}
+	})
+}
+
+func TestWithTempFile(t *testing.T) {
+	t.Run("passes a writable file and removes it", func(t *testing.T) {
+		var name string
+		result := filefp.WithTempFile(func(f *os.File) (int, error) {
+			name = f.Name()
+			return f.WriteString("scratch")
+		})
+
+		if result.OrPanic() != 7 {
+			t.Errorf("expected 7 bytes written, got %v", result)
+		}
+		if _, err := os.Stat(name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected temp file removed, got %v", err)
+		}
+	})
+
+	t.Run("removes the file when fn fails or panics", func(t *testing.T) {
+		errScan := errors.New("scan failed")
+		for name, fn := range map[string]func(*os.File) (int, error){
+			"error": func(*os.File) (int, error) { return 0, errScan },
+			"panic": func(*os.File) (int, error) { panic("boom") },
+		} {
+			var path string
+			result := filefp.WithTempFile(func(f *os.File) (int, error) {
+				path = f.Name()
+				return fn(f)
+			})
+			if _, ok := result.(maybe.Failure[int]); !ok {
+				t.Errorf("%s: expected Failure, got %v", name, result)
+			}
+			if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("%s: expected temp file removed, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("tolerates fn removing the file", func(t *testing.T) {
+		result := filefp.WithTempFile(func(f *os.File) (string, error) {
+			return "done", os.Remove(f.Name())
+		})
+		if result.OrPanic() != "done" {
+			t.Errorf("expected Just(done), got %v", result)
+		}
+	})
+
+	t.Run("reports cleanup failure", func(t *testing.T) {
+		var path string
+		result := filefp.WithTempFile(func(f *os.File) (string, error) {
+			path = f.Name()
+			os.Remove(path)
+			os.Mkdir(path, 0o755)
+			return "done", os.WriteFile(filepath.Join(path, "child"), nil, 0o644)
+		})
+		defer os.RemoveAll(path)
+
+		if _, ok := result.(maybe.Failure[string]); !ok {
+			t.Errorf("expected Failure, got %v", result)
+		}
+	})
+
+	t.Run("returns Failure when the file cannot be created", func(t *testing.T) {
+		t.Setenv("TMPDIR", filepath.Join(t.TempDir(), "missing"))
+		called := false
+		result := filefp.WithTempFile(func(f *os.File) (int, error) {
+			called = true
+			return 0, nil
+		})
+		if !result.ErrIs(fs.ErrNotExist) || called {
+			t.Errorf("expected ErrNotExist without calling fn, got %v", result)
+		}
+	})
+}
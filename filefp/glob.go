@@ -0,0 +1,53 @@
+package filefp
+
+import (
+	"path/filepath"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// MatchGlob returns a predicate reporting whether a path matches pattern, using the
+// filepath.Match syntax. The whole path is matched and '*' does not cross separators,
+// so use a pattern like "logs/*.log" for a path under logs. Use it with Filter.
+//
+// A malformed pattern makes the predicate panic with filepath.ErrBadPattern, which Filter
+// converts to a Failure. Use EnsureGlob to get that Failure without relying on Filter.
+//
+// Example:
+//
+//	logs := maybe.Map(entry, func(e filefp.DirEntry) string { return e.Path }).
+//	    Filter(filefp.MatchGlob("logs/*.log"))
+func MatchGlob(pattern string) func(path string) bool {
+	return func(path string) bool {
+		ok, err := filepath.Match(pattern, path)
+		if err != nil {
+			panic(err)
+		}
+		return ok
+	}
+}
+
+// EnsureGlob returns a step that keeps a path only if it matches pattern.
+// Its signature fits FlatMap directly.
+//
+// Behavior:
+//   - If path matches: returns Just(path)
+//   - If path does not match: returns None
+//   - If pattern is malformed: returns Failure with filepath.ErrBadPattern
+//
+// Example:
+//
+//	configs := maybe.FlatMap(path, filefp.EnsureGlob("conf.d/*.yaml"))
+func EnsureGlob(pattern string) func(path string) maybe.Maybe[string] {
+	return func(path string) maybe.Maybe[string] {
+		ok, err := filepath.Match(pattern, path)
+		switch {
+		case err != nil:
+			return maybe.Failed[string](err)
+		case ok:
+			return maybe.Just(path)
+		default:
+			return maybe.Empty[string]()
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package filefp_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/filefp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestMatchGlob(t *testing.T) {
+	isLog := filefp.MatchGlob("logs/*.log")
+
+	t.Run("matches whole path", func(t *testing.T) {
+		if !isLog("logs/app.log") {
+			t.Error("expected logs/app.log to match")
+		}
+		if isLog("logs/old/app.log") || isLog("app.log") || isLog("logs/app.txt") {
+			t.Error("expected non-matching paths to be rejected")
+		}
+	})
+
+	t.Run("works with Filter", func(t *testing.T) {
+		if _, ok := maybe.Just("logs/app.log").Filter(isLog).(maybe.Some[string]); !ok {
+			t.Error("expected matching path to be kept")
+		}
+		if _, ok := maybe.Just("readme.md").Filter(isLog).(maybe.None[string]); !ok {
+			t.Error("expected other path to be filtered out")
+		}
+	})
+
+	t.Run("malformed pattern becomes Failure through Filter", func(t *testing.T) {
+		if _, ok := maybe.Just("a").Filter(filefp.MatchGlob("[")).(maybe.Failure[string]); !ok {
+			t.Error("expected Failure")
+		}
+	})
+}
+
+func TestEnsureGlob(t *testing.T) {
+	ensure := filefp.EnsureGlob("conf.d/*.yaml")
+
+	t.Run("keeps matching path", func(t *testing.T) {
+		if got := maybe.FlatMap(maybe.Just("conf.d/db.yaml"), ensure).OrPanic(); got != "conf.d/db.yaml" {
+			t.Errorf("unexpected result %s", got)
+		}
+	})
+
+	t.Run("returns None for other path", func(t *testing.T) {
+		if _, ok := ensure("conf.d/db.json").(maybe.None[string]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("returns Failure for malformed pattern", func(t *testing.T) {
+		if !filefp.EnsureGlob("[")("a").ErrIs(filepath.ErrBadPattern) {
+			t.Error("expected ErrBadPattern")
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package filefp
+
+import (
+	"io/fs"
+	"iter"
+	"path/filepath"
+	"slices"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// DirEntry is an entry found by Walk, with the path it was found at.
+type DirEntry struct {
+	Path string
+	fs.DirEntry
+}
+
+// Walk lazily yields every file and directory under root, root included, in lexical order.
+// An entry that cannot be read (for example a directory without read permission) is yielded
+// as a Failure, and not also as Some, and the walk continues with its siblings instead of
+// aborting. Symbolic links are yielded but not followed. Stopping the range loop stops the walk.
+//
+// Behavior:
+//   - Readable entries: yielded as Just(DirEntry)
+//   - Unreadable entries, including a missing root: yielded as Failure with the *fs.PathError
+//
+// Example:
+//
+//	for entry := range filefp.Walk("./logs") {
+//	    entry.Filter(filefp.HasExt(".log")).MatchThen(
+//	        func(e filefp.DirEntry) { archive(e.Path) },
+//	        func() {},
+//	        func(err error) { log.Printf("skipped: %v", err) },
+//	    )
+//	}
+func Walk(root string) iter.Seq[maybe.Maybe[DirEntry]] {
+	return func(yield func(maybe.Maybe[DirEntry]) bool) {
+		walk(yield, func(fn fs.WalkDirFunc) { filepath.WalkDir(root, fn) })
+	}
+}
+
+// WalkFS is Walk over fsys, such as an embed.FS or os.DirFS. Paths are slash-separated
+// and relative to fsys, as with fs.WalkDir.
+//
+// Example:
+//
+//	templates := filefp.WalkFS(embedded, "templates")
+func WalkFS(fsys fs.FS, root string) iter.Seq[maybe.Maybe[DirEntry]] {
+	return func(yield func(maybe.Maybe[DirEntry]) bool) {
+		walk(yield, func(fn fs.WalkDirFunc) { fs.WalkDir(fsys, root, fn) })
+	}
+}
+
+// walk runs a WalkDir through run and yields its entries. WalkDir visits a directory before
+// listing it and, if the listing fails, visits it again with the error, so a directory is
+// held back until the next visit shows whether it could be listed: each entry is then
+// yielded exactly once, as Some or as Failure.
+func walk(yield func(maybe.Maybe[DirEntry]) bool, run func(fs.WalkDirFunc)) {
+	var pending *DirEntry
+	stopped := false
+	emit := func(m maybe.Maybe[DirEntry]) bool {
+		stopped = !yield(m)
+		return !stopped
+	}
+	flush := func() bool {
+		if pending == nil {
+			return true
+		}
+		dir := *pending
+		pending = nil
+		return emit(maybe.Just(dir))
+	}
+
+	run(func(path string, d fs.DirEntry, err error) error {
+		if err != nil && pending != nil && pending.Path == path {
+			pending = nil // the directory could not be listed; report only the Failure
+		}
+		if !flush() {
+			return fs.SkipAll
+		}
+		switch {
+		case err != nil:
+			// Returning nil after an error moves on to the entry's siblings.
+			if !emit(maybe.Failed[DirEntry](err)) {
+				return fs.SkipAll
+			}
+		case d.IsDir():
+			pending = &DirEntry{Path: path, DirEntry: d}
+		default:
+			if !emit(maybe.Just(DirEntry{Path: path, DirEntry: d})) {
+				return fs.SkipAll
+			}
+		}
+		return nil
+	})
+	if !stopped {
+		flush()
+	}
+}
+
+// IsFile reports whether the entry is a regular file. Use it with Filter.
+func IsFile(e DirEntry) bool {
+	return e.Type().IsRegular()
+}
+
+// IsDir reports whether the entry is a directory. Use it with Filter.
+func IsDir(e DirEntry) bool {
+	return e.IsDir()
+}
+
+// HasExt returns a predicate reporting whether the entry's name ends in one of exts,
+// such as ".go". Use it with Filter.
+//
+// Example:
+//
+//	images := entry.Filter(filefp.HasExt(".png", ".jpg"))
+func HasExt(exts ...string) func(DirEntry) bool {
+	return func(e DirEntry) bool {
+		return slices.Contains(exts, filepath.Ext(e.Name()))
+	}
+}
@@ -0,0 +1,150 @@
+package filefp_test
+
+import (
+	"errors"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/lonelywolflee/lw-project-fp-go/filefp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+var errLocked = errors.New("permission denied")
+
+// lockedFS fails to list the "locked" directory.
+type lockedFS struct{ fstest.MapFS }
+
+func (f lockedFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == "locked" {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errLocked}
+	}
+	return f.MapFS.ReadDir(name)
+}
+
+func collect(entries iter.Seq[maybe.Maybe[filefp.DirEntry]]) (paths []string, errs []error) {
+	for m := range entries {
+		m.MatchThen(
+			func(e filefp.DirEntry) { paths = append(paths, e.Path) },
+			func() {},
+			func(err error) { errs = append(errs, err) },
+		)
+	}
+	return paths, errs
+}
+
+func TestWalk(t *testing.T) {
+	root := t.TempDir()
+	os.MkdirAll(filepath.Join(root, "sub"), 0o755)
+	os.WriteFile(filepath.Join(root, "a.log"), nil, 0o644)
+	os.WriteFile(filepath.Join(root, "sub", "b.txt"), nil, 0o644)
+
+	t.Run("yields root and every entry in lexical order", func(t *testing.T) {
+		paths, errs := collect(filefp.Walk(root))
+		want := []string{root, filepath.Join(root, "a.log"), filepath.Join(root, "sub"), filepath.Join(root, "sub", "b.txt")}
+		if !slices.Equal(paths, want) || len(errs) != 0 {
+			t.Errorf("expected %v, got %v (errors %v)", want, paths, errs)
+		}
+	})
+
+	t.Run("stops when the loop breaks", func(t *testing.T) {
+		n := 0
+		for range filefp.Walk(root) {
+			n++
+			break
+		}
+		if n != 1 {
+			t.Errorf("expected 1 entry, got %d", n)
+		}
+	})
+
+	t.Run("yields Failure for missing root", func(t *testing.T) {
+		paths, errs := collect(filefp.Walk(filepath.Join(root, "missing")))
+		if len(paths) != 0 || len(errs) != 1 || !errors.Is(errs[0], fs.ErrNotExist) {
+			t.Errorf("expected one ErrNotExist, got %v %v", paths, errs)
+		}
+	})
+}
+
+func TestWalkFS(t *testing.T) {
+	fsys := lockedFS{fstest.MapFS{
+		"a.go":          {},
+		"locked/secret": {},
+		"z/c.go":        {},
+	}}
+
+	t.Run("reports unreadable directory and keeps walking", func(t *testing.T) {
+		paths, errs := collect(filefp.WalkFS(fsys, "."))
+		want := []string{".", "a.go", "z", "z/c.go"}
+		if !slices.Equal(paths, want) {
+			t.Errorf("expected %v, got %v", want, paths)
+		}
+		if len(errs) != 1 || !errors.Is(errs[0], errLocked) {
+			t.Errorf("expected one permission error, got %v", errs)
+		}
+	})
+
+	t.Run("yields an unreadable directory once, in order, as Failure", func(t *testing.T) {
+		var got []string
+		for m := range filefp.WalkFS(fsys, ".") {
+			m.MatchThen(
+				func(e filefp.DirEntry) { got = append(got, e.Path) },
+				func() {},
+				func(error) { got = append(got, "error") },
+			)
+		}
+		if want := []string{".", "a.go", "error", "z", "z/c.go"}; !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("stops at whichever entry the loop breaks on", func(t *testing.T) {
+		for _, stopAt := range []string{"a.go", "error", "z/c.go"} {
+			var got []string
+			for m := range filefp.WalkFS(fsys, ".") {
+				path := m.GetOrZero().Path
+				if m.IsFailed() {
+					path = "error"
+				}
+				got = append(got, path)
+				if path == stopAt {
+					break
+				}
+			}
+			if got[len(got)-1] != stopAt {
+				t.Errorf("expected the walk to stop at %s, got %v", stopAt, got)
+			}
+		}
+	})
+
+	t.Run("yields an empty directory after the walk ends", func(t *testing.T) {
+		paths, errs := collect(filefp.WalkFS(fstest.MapFS{"empty": {Mode: fs.ModeDir}}, "."))
+		if !slices.Equal(paths, []string{".", "empty"}) || len(errs) != 0 {
+			t.Errorf("expected [. empty], got %v (errors %v)", paths, errs)
+		}
+	})
+}
+
+func TestPredicates(t *testing.T) {
+	fsys := fstest.MapFS{"img/a.png": {}, "img/b.jpg": {}, "img/c.txt": {}}
+	var files, dirs, images []string
+	for m := range filefp.WalkFS(fsys, ".") {
+		m.Filter(filefp.IsFile).Then(func(e filefp.DirEntry) { files = append(files, e.Path) })
+		m.Filter(filefp.IsDir).Then(func(e filefp.DirEntry) { dirs = append(dirs, e.Path) })
+		m.Filter(filefp.HasExt(".png", ".jpg")).Then(func(e filefp.DirEntry) { images = append(images, e.Path) })
+	}
+
+	if !slices.Equal(files, []string{"img/a.png", "img/b.jpg", "img/c.txt"}) {
+		t.Errorf("unexpected files %v", files)
+	}
+	if !slices.Equal(dirs, []string{".", "img"}) {
+		t.Errorf("unexpected dirs %v", dirs)
+	}
+	if !slices.Equal(images, []string{"img/a.png", "img/b.jpg"}) {
+		t.Errorf("unexpected images %v", images)
+	}
+}
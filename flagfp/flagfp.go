@@ -0,0 +1,120 @@
+package flagfp
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ParseError is stored as the Failure of a flag whose command-line value could not be parsed.
+type ParseError struct {
+	Flag  string
+	Value string
+	Err   error
+}
+
+// Error returns a message naming the flag and the rejected value.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("flagfp: invalid value %q for flag -%s: %v", e.Value, e.Flag, e.Err)
+}
+
+// Unwrap returns the parser's error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// value is the flag.Value behind every binding: it starts as None and becomes Some or
+// Failure when the flag appears on the command line.
+type value[T any] struct {
+	name   string
+	target *maybe.Maybe[T]
+	parse  func(string) (T, error)
+	isBool bool
+}
+
+func (v *value[T]) Set(s string) error {
+	parsed, err := v.parse(s)
+	if err != nil {
+		*v.target = maybe.Failed[T](&ParseError{Flag: v.name, Value: s, Err: err})
+		return nil
+	}
+	*v.target = maybe.Just(parsed)
+	return nil
+}
+
+func (v *value[T]) String() string {
+	if v == nil || v.target == nil {
+		return ""
+	}
+	if x, ok, _ := (*v.target).Get(); ok {
+		return fmt.Sprint(x)
+	}
+	return ""
+}
+
+func (v *value[T]) IsBoolFlag() bool {
+	return v != nil && v.isBool
+}
+
+// Var defines a flag parsed with parse and returns the Maybe it is bound to.
+//
+// Behavior after fs.Parse:
+//   - If the flag was not given: None
+//   - If the flag was given and parse succeeded: Just(value), even for an empty or zero value
+//   - If parse failed: Failure(*ParseError); the failure is kept in the Maybe rather than
+//     aborting fs.Parse, so check it before use
+//
+// Example:
+//
+//	listen := flagfp.Var(fs, "listen", "listen address", netip.ParseAddrPort)
+func Var[T any](fs *flag.FlagSet, name, usage string, parse func(string) (T, error)) *maybe.Maybe[T] {
+	return bind(fs, name, usage, parse, false)
+}
+
+func bind[T any](fs *flag.FlagSet, name, usage string, parse func(string) (T, error), isBool bool) *maybe.Maybe[T] {
+	target := new(maybe.Maybe[T])
+	*target = maybe.Empty[T]()
+	fs.Var(&value[T]{name: name, target: target, parse: parse, isBool: isBool}, name, usage)
+	return target
+}
+
+// String defines a string flag that stays None unless given, so "unset" and
+// "set to empty" can be told apart.
+//
+// Example:
+//
+//	region := flagfp.String(fs, "region", "deployment region")
+//	fs.Parse(os.Args[1:])
+//	r := (*region).OrElseDefault(detectRegion())
+func String(fs *flag.FlagSet, name, usage string) *maybe.Maybe[string] {
+	return Var(fs, name, usage, func(s string) (string, error) { return s, nil })
+}
+
+// Int defines an int flag; see Var for the resulting states.
+func Int(fs *flag.FlagSet, name, usage string) *maybe.Maybe[int] {
+	return Var(fs, name, usage, func(s string) (int, error) {
+		n, err := strconv.ParseInt(s, 0, strconv.IntSize)
+		return int(n), err
+	})
+}
+
+// Float64 defines a float64 flag; see Var for the resulting states.
+func Float64(fs *flag.FlagSet, name, usage string) *maybe.Maybe[float64] {
+	return Var(fs, name, usage, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// Duration defines a time.Duration flag; see Var for the resulting states.
+func Duration(fs *flag.FlagSet, name, usage string) *maybe.Maybe[time.Duration] {
+	return Var(fs, name, usage, time.ParseDuration)
+}
+
+// Bool defines a boolean flag that may be given as -name or -name=value;
+// see Var for the resulting states.
+func Bool(fs *flag.FlagSet, name, usage string) *maybe.Maybe[bool] {
+	return bind(fs, name, usage, strconv.ParseBool, true)
+}
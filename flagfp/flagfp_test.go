@@ -0,0 +1,165 @@
+package flagfp_test
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/flagfp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(new(bytes.Buffer))
+	return fs
+}
+
+func TestString(t *testing.T) {
+	t.Run("is None when not given", func(t *testing.T) {
+		fs := newFlagSet()
+		region := flagfp.String(fs, "region", "")
+		if err := fs.Parse(nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := (*region).(maybe.None[string]); !ok {
+			t.Error("expected None for unset flag")
+		}
+	})
+
+	t.Run("distinguishes empty from unset", func(t *testing.T) {
+		fs := newFlagSet()
+		region := flagfp.String(fs, "region", "")
+		if err := fs.Parse([]string{"-region="}); err != nil {
+			t.Fatal(err)
+		}
+		if v, ok, _ := (*region).Get(); !ok || v != "" {
+			t.Errorf("expected Just(\"\"), got %q (ok=%v)", v, ok)
+		}
+	})
+
+	t.Run("holds the given value", func(t *testing.T) {
+		fs := newFlagSet()
+		region := flagfp.String(fs, "region", "")
+		_ = fs.Parse([]string{"-region", "eu-west-1"})
+		if v, _, _ := (*region).Get(); v != "eu-west-1" {
+			t.Errorf("expected eu-west-1, got %q", v)
+		}
+	})
+}
+
+func TestTypedFlags(t *testing.T) {
+	t.Run("parses typed values", func(t *testing.T) {
+		fs := newFlagSet()
+		n := flagfp.Int(fs, "n", "")
+		ratio := flagfp.Float64(fs, "ratio", "")
+		timeout := flagfp.Duration(fs, "timeout", "")
+		if err := fs.Parse([]string{"-n", "0x10", "-ratio", "0.5", "-timeout", "1m30s"}); err != nil {
+			t.Fatal(err)
+		}
+
+		if v, _, _ := (*n).Get(); v != 16 {
+			t.Errorf("expected 16, got %d", v)
+		}
+		if v, _, _ := (*ratio).Get(); v != 0.5 {
+			t.Errorf("expected 0.5, got %v", v)
+		}
+		if v, _, _ := (*timeout).Get(); v != 90*time.Second {
+			t.Errorf("expected 1m30s, got %s", v)
+		}
+	})
+
+	t.Run("stores parse errors as Failure", func(t *testing.T) {
+		fs := newFlagSet()
+		n := flagfp.Int(fs, "n", "")
+		if err := fs.Parse([]string{"-n", "many"}); err != nil {
+			t.Fatalf("expected parse to continue, got %v", err)
+		}
+
+		_, _, err := (*n).Get()
+		var parseErr *flagfp.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Flag != "n" || parseErr.Value != "many" {
+			t.Fatalf("expected ParseError for -n, got %v", err)
+		}
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("expected wrapped strconv.ErrSyntax, got %v", err)
+		}
+		if want := `flagfp: invalid value "many" for flag -n: `; !strings.HasPrefix(err.Error(), want) {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	})
+
+	t.Run("supports custom parsers", func(t *testing.T) {
+		fs := newFlagSet()
+		listen := flagfp.Var(fs, "listen", "", netip.ParseAddrPort)
+		_ = fs.Parse([]string{"-listen", "127.0.0.1:8080"})
+
+		if v, _, _ := (*listen).Get(); v.Port() != 8080 {
+			t.Errorf("expected port 8080, got %v", v)
+		}
+	})
+}
+
+func TestBool(t *testing.T) {
+	t.Run("accepts bare flag", func(t *testing.T) {
+		fs := newFlagSet()
+		verbose := flagfp.Bool(fs, "v", "")
+		if err := fs.Parse([]string{"-v"}); err != nil {
+			t.Fatal(err)
+		}
+		if v, ok, _ := (*verbose).Get(); !ok || !v {
+			t.Error("expected Just(true)")
+		}
+	})
+
+	t.Run("distinguishes false from unset", func(t *testing.T) {
+		fs := newFlagSet()
+		verbose := flagfp.Bool(fs, "v", "")
+		other := flagfp.Bool(fs, "q", "")
+		_ = fs.Parse([]string{"-v=false"})
+
+		if v, ok, _ := (*verbose).Get(); !ok || v {
+			t.Error("expected Just(false)")
+		}
+		if _, ok := (*other).(maybe.None[bool]); !ok {
+			t.Error("expected None for unset bool flag")
+		}
+	})
+}
+
+func TestFlagValue(t *testing.T) {
+	t.Run("reports current value through flag.Value", func(t *testing.T) {
+		fs := newFlagSet()
+		flagfp.String(fs, "region", "")
+		flagfp.Int(fs, "n", "")
+		_ = fs.Parse([]string{"-n", "3"})
+
+		if got := fs.Lookup("n").Value.String(); got != "3" {
+			t.Errorf("expected 3, got %q", got)
+		}
+		if got := fs.Lookup("region").Value.String(); got != "" {
+			t.Errorf("expected empty string for unset flag, got %q", got)
+		}
+	})
+
+	t.Run("prints usage without defaults", func(t *testing.T) {
+		fs := newFlagSet()
+		out := new(bytes.Buffer)
+		fs.SetOutput(out)
+		flagfp.String(fs, "region", "deployment region")
+		flagfp.Bool(fs, "v", "verbose output")
+		fs.PrintDefaults()
+
+		if strings.Contains(out.String(), "default") {
+			t.Errorf("expected no default shown, got %q", out.String())
+		}
+		if !strings.Contains(out.String(), "deployment region") {
+			t.Errorf("expected usage text, got %q", out.String())
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package hashfp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrMACMismatch is returned by HMACVerify when the MAC does not match the message.
+var ErrMACMismatch = errors.New("hashfp: MAC mismatch")
+
+// SHA256Hex returns the lowercase hex SHA-256 digest of data. It always returns Some;
+// the Maybe return lets it sit in a chain next to steps that can fail.
+//
+// Example:
+//
+//	digest := hashfp.SHA256Hex(payload).
+//	    Filter(func(d string) bool { return d == expected })
+func SHA256Hex(data []byte) maybe.Maybe[string] {
+	sum := sha256.Sum256(data)
+	return maybe.Just(hex.EncodeToString(sum[:]))
+}
+
+// SHA256Reader returns the lowercase hex SHA-256 digest of everything read from r,
+// for hashing files and other streams without loading them into memory.
+//
+// Behavior:
+//   - If r is read to EOF: returns Just(digest)
+//   - If reading fails: returns Failure with the read error
+//
+// Example:
+//
+//	file := maybe.Try(func() (*os.File, error) { return os.Open(path) })
+//	digest := maybe.FlatMap(file, func(f *os.File) maybe.Maybe[string] {
+//	    defer f.Close()
+//	    return hashfp.SHA256Reader(f)
+//	})
+func SHA256Reader(r io.Reader) maybe.Maybe[string] {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return maybe.Failed[string](err)
+	}
+	return maybe.Just(hex.EncodeToString(h.Sum(nil)))
+}
+
+// HMACSHA256Hex returns the lowercase hex HMAC-SHA256 of message under key.
+func HMACSHA256Hex(key, message []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HMACVerify checks macHex, a hex HMAC-SHA256 as produced by HMACSHA256Hex, against message
+// under key. The comparison is constant-time.
+//
+// Behavior:
+//   - If the MAC matches: returns Just(struct{}{})
+//   - If macHex is not valid hex: returns Failure with the decoding error
+//   - If the MAC does not match: returns Failure with ErrMACMismatch
+//
+// Example:
+//
+//	event := maybe.FlatMap(
+//	    hashfp.HMACVerify(secret, body, req.Header.Get("X-Signature")),
+//	    func(struct{}) maybe.Maybe[Event] { return decodeEvent(body) },
+//	)
+func HMACVerify(key, message []byte, macHex string) maybe.Maybe[struct{}] {
+	got, err := hex.DecodeString(macHex)
+	if err != nil {
+		return maybe.Failed[struct{}](err)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return maybe.Failed[struct{}](ErrMACMismatch)
+	}
+	return maybe.Just(struct{}{})
+}
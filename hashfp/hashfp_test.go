@@ -0,0 +1,66 @@
+package hashfp_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/lonelywolflee/lw-project-fp-go/hashfp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+const helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestSHA256Hex(t *testing.T) {
+	t.Run("returns hex digest", func(t *testing.T) {
+		if got := hashfp.SHA256Hex([]byte("hello")).OrPanic(); got != helloSHA256 {
+			t.Errorf("unexpected digest %s", got)
+		}
+	})
+}
+
+func TestSHA256Reader(t *testing.T) {
+	t.Run("hashes everything read", func(t *testing.T) {
+		if got := hashfp.SHA256Reader(strings.NewReader("hello")).OrPanic(); got != helloSHA256 {
+			t.Errorf("unexpected digest %s", got)
+		}
+	})
+
+	t.Run("returns Failure on read error", func(t *testing.T) {
+		errRead := errors.New("disk gone")
+		_, _, err := hashfp.SHA256Reader(iotest.ErrReader(errRead)).Get()
+		if !errors.Is(err, errRead) {
+			t.Errorf("expected read error, got %v", err)
+		}
+	})
+}
+
+func TestHMACVerify(t *testing.T) {
+	key, msg := []byte("secret"), []byte(`{"event":"paid"}`)
+	mac := hashfp.HMACSHA256Hex(key, msg)
+
+	t.Run("accepts matching MAC", func(t *testing.T) {
+		if _, ok := hashfp.HMACVerify(key, msg, mac).(maybe.Some[struct{}]); !ok {
+			t.Error("expected Some")
+		}
+	})
+
+	t.Run("rejects tampered message or wrong key", func(t *testing.T) {
+		for name, m := range map[string]maybe.Maybe[struct{}]{
+			"message": hashfp.HMACVerify(key, []byte(`{"event":"refunded"}`), mac),
+			"key":     hashfp.HMACVerify([]byte("other"), msg, mac),
+		} {
+			if !m.ErrIs(hashfp.ErrMACMismatch) {
+				t.Errorf("%s: expected ErrMACMismatch", name)
+			}
+		}
+	})
+
+	t.Run("returns Failure for invalid hex", func(t *testing.T) {
+		_, _, err := hashfp.HMACVerify(key, msg, "zz").Get()
+		if err == nil || errors.Is(err, hashfp.ErrMACMismatch) {
+			t.Errorf("expected decoding error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package health
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrNoHealthySource is returned by Ladder when every source was skipped as unhealthy.
+var ErrNoHealthySource = errors.New("health: no healthy source")
+
+// Prober reports whether a dependency is currently worth calling. *Tracker implements it.
+type Prober interface {
+	Healthy() bool
+}
+
+// recorder is implemented by probes that also learn from outcomes, such as *Tracker.
+type recorder interface {
+	RecordSuccess()
+	RecordFailure()
+}
+
+// Source is one rung of a fallback ladder.
+type Source[T any] struct {
+	// Name identifies the source in errors.
+	Name string
+	// Probe is consulted before Fetch; a nil Probe is always healthy. If the probe also
+	// records outcomes (as *Tracker does), the result of Fetch is recorded on it.
+	Probe Prober
+	// Fetch produces the value.
+	Fetch func() maybe.Maybe[T]
+}
+
+// Ladder tries sources in priority order and returns the first Some. Sources whose Probe
+// reports unhealthy are skipped without being called, so known-bad dependencies cost nothing.
+//
+// Behavior:
+//   - Returns the first Some from a healthy source
+//   - None and Failure results move on to the next source
+//   - If no source produced Some and at least one failed: returns Failure joining those errors
+//   - If every attempted source returned None: returns None
+//   - If every source was skipped: returns Failure wrapping ErrNoHealthySource and naming
+//     the skipped sources; with no sources at all, the error is ErrNoHealthySource itself
+//   - A panicking Fetch counts as a Failure
+//
+// Example:
+//
+//	primary := health.NewTracker(health.DefaultConfig)
+//	price := health.Ladder(
+//	    health.Source[Price]{Name: "primary", Probe: primary, Fetch: fetchPrimary},
+//	    health.Source[Price]{Name: "replica", Probe: replica, Fetch: fetchReplica},
+//	    health.Source[Price]{Name: "cache", Fetch: fetchCached},
+//	)
+func Ladder[T any](sources ...Source[T]) maybe.Maybe[T] {
+	var errs []error
+	var skipped []string
+	attempted := false
+
+	for _, s := range sources {
+		if s.Probe != nil && !s.Probe.Healthy() {
+			skipped = append(skipped, s.Name)
+			continue
+		}
+		attempted = true
+
+		result := maybe.Do(s.Fetch)
+		_, ok, err := result.Get()
+		if r, isRecorder := s.Probe.(recorder); isRecorder {
+			if err != nil {
+				r.RecordFailure()
+			} else {
+				r.RecordSuccess()
+			}
+		}
+		if ok {
+			return result
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("source %q: %w", s.Name, err))
+		}
+	}
+
+	switch {
+	case len(errs) > 0:
+		return maybe.Failed[T](errors.Join(errs...))
+	case attempted:
+		return maybe.Empty[T]()
+	case len(skipped) > 0:
+		return maybe.Failed[T](fmt.Errorf("%w: skipped %q", ErrNoHealthySource, skipped))
+	default:
+		return maybe.Failed[T](ErrNoHealthySource)
+	}
+}
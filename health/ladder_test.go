@@ -0,0 +1,120 @@
+package health_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/health"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type staticProbe bool
+
+func (p staticProbe) Healthy() bool { return bool(p) }
+
+func fetching(v int, calls *[]string, name string) func() maybe.Maybe[int] {
+	return func() maybe.Maybe[int] {
+		*calls = append(*calls, name)
+		return maybe.Just(v)
+	}
+}
+
+func TestLadder(t *testing.T) {
+	t.Run("returns first healthy Some", func(t *testing.T) {
+		var calls []string
+		result := health.Ladder(
+			health.Source[int]{Name: "primary", Probe: staticProbe(false), Fetch: fetching(1, &calls, "primary")},
+			health.Source[int]{Name: "replica", Probe: staticProbe(true), Fetch: fetching(2, &calls, "replica")},
+			health.Source[int]{Name: "cache", Fetch: fetching(3, &calls, "cache")},
+		)
+
+		if v, _, _ := result.Get(); v != 2 {
+			t.Errorf("expected 2 from replica, got %d", v)
+		}
+		if strings.Join(calls, ",") != "replica" {
+			t.Errorf("expected only replica to be called, got %v", calls)
+		}
+	})
+
+	t.Run("falls through None and Failure", func(t *testing.T) {
+		var calls []string
+		result := health.Ladder(
+			health.Source[int]{Name: "a", Fetch: func() maybe.Maybe[int] { return maybe.Empty[int]() }},
+			health.Source[int]{Name: "b", Fetch: func() maybe.Maybe[int] { return maybe.Failed[int](errors.New("down")) }},
+			health.Source[int]{Name: "c", Fetch: fetching(3, &calls, "c")},
+		)
+
+		if v, _, _ := result.Get(); v != 3 {
+			t.Errorf("expected 3, got %d", v)
+		}
+	})
+
+	t.Run("joins errors when nothing succeeds", func(t *testing.T) {
+		errA := errors.New("timeout")
+		result := health.Ladder(
+			health.Source[int]{Name: "a", Fetch: func() maybe.Maybe[int] { return maybe.Failed[int](errA) }},
+			health.Source[int]{Name: "b", Fetch: func() maybe.Maybe[int] { panic("b exploded") }},
+			health.Source[int]{Name: "c", Fetch: func() maybe.Maybe[int] { return maybe.Empty[int]() }},
+		)
+
+		_, _, err := result.Get()
+		if !errors.Is(err, errA) || !strings.Contains(err.Error(), `source "a"`) || !strings.Contains(err.Error(), `source "b"`) {
+			t.Errorf("expected joined source errors, got %v", err)
+		}
+	})
+
+	t.Run("returns None when every attempt is empty", func(t *testing.T) {
+		result := health.Ladder(
+			health.Source[int]{Name: "a", Probe: staticProbe(false), Fetch: func() maybe.Maybe[int] { return maybe.Just(1) }},
+			health.Source[int]{Name: "b", Fetch: func() maybe.Maybe[int] { return maybe.Empty[int]() }},
+		)
+
+		if _, ok := result.(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("fails when every source is unhealthy", func(t *testing.T) {
+		result := health.Ladder(
+			health.Source[int]{Name: "a", Probe: staticProbe(false)},
+		)
+
+		if _, _, err := result.Get(); !errors.Is(err, health.ErrNoHealthySource) || !strings.Contains(err.Error(), `skipped ["a"]`) {
+			t.Errorf("expected ErrNoHealthySource naming the skipped source, got %v", err)
+		}
+		if _, _, err := health.Ladder[int]().Get(); !errors.Is(err, health.ErrNoHealthySource) {
+			t.Errorf("expected ErrNoHealthySource for no sources, got %v", err)
+		}
+	})
+
+	t.Run("records outcomes on tracker probes", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{MinSamples: 2, MaxFailureRate: 0.5})
+		failing := health.Source[int]{Name: "flaky", Probe: tracker, Fetch: func() maybe.Maybe[int] {
+			return maybe.Failed[int](errors.New("503"))
+		}}
+		fallback := health.Source[int]{Name: "cache", Fetch: func() maybe.Maybe[int] { return maybe.Just(0) }}
+
+		health.Ladder(failing, fallback)
+		health.Ladder(failing, fallback)
+		if tracker.Healthy() {
+			t.Fatal("expected tracker to turn unhealthy after recorded failures")
+		}
+
+		called := false
+		failing.Fetch = func() maybe.Maybe[int] { called = true; return maybe.Just(1) }
+		if v, _, _ := health.Ladder(failing, fallback).Get(); v != 0 || called {
+			t.Errorf("expected unhealthy source to be skipped, got %d (called=%v)", v, called)
+		}
+	})
+
+	t.Run("records success on tracker probes", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{})
+		tracker.RecordFailure()
+		health.Ladder(health.Source[int]{Name: "ok", Probe: tracker, Fetch: func() maybe.Maybe[int] { return maybe.Just(1) }})
+
+		if tracker.FailureRate() != 0.5 {
+			t.Errorf("expected recorded success, got failure rate %v", tracker.FailureRate())
+		}
+	})
+}
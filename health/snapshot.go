@@ -0,0 +1,160 @@
+package health
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Status is the health of one component at the moment it was asked. Fields a component
+// does not measure are None, which marshals to JSON as null. Registry.Snapshot replaces
+// any nil field with None, so every field of a reported Status is safe to call.
+type Status struct {
+	// Name is the name the component was registered under; Registry fills it in.
+	Name string `json:"name"`
+	// Healthy reports whether the component is within its budget.
+	Healthy bool `json:"healthy"`
+	// FailureRate is the recent fraction of failed outcomes, between 0 and 1.
+	FailureRate maybe.Maybe[float64] `json:"failure_rate"`
+	// Depth is the number of items waiting, for queues and buffers.
+	Depth maybe.Maybe[int] `json:"depth"`
+	// LastSuccess is the time of the most recent successful outcome.
+	LastSuccess maybe.Maybe[time.Time] `json:"last_success"`
+	// Error describes why the status could not be read, if it could not.
+	Error string `json:"error,omitempty"`
+}
+
+// Component is a subsystem that can report its health. *Tracker implements it.
+type Component interface {
+	HealthStatus() Status
+}
+
+// ComponentFunc adapts a function to a Component.
+type ComponentFunc func() Status
+
+// HealthStatus calls f.
+func (f ComponentFunc) HealthStatus() Status {
+	return f()
+}
+
+// QueueDepth reports the length of a queue, such as a *queue.Queue, as a Component.
+// The queue counts as unhealthy once its length exceeds max; a max of 0 or less never does.
+//
+// Example:
+//
+//	health.Register("jobs", health.QueueDepth(jobs, 10_000))
+func QueueDepth(q interface{ Len() int }, max int) Component {
+	return ComponentFunc(func() Status {
+		depth := q.Len()
+		return Status{
+			Healthy:     max <= 0 || depth <= max,
+			FailureRate: maybe.Empty[float64](),
+			Depth:       maybe.Just(depth),
+			LastSuccess: maybe.Empty[time.Time](),
+		}
+	})
+}
+
+// Report is the health of every registered component at one point in time.
+type Report struct {
+	// Healthy is true when every component is healthy.
+	Healthy bool `json:"healthy"`
+	// Components holds one Status per component, sorted by name.
+	Components []Status `json:"components"`
+}
+
+// Registry collects named components whose health is read together. It is safe for
+// concurrent use. Most programs use the package-level Register and Snapshot, which share
+// DefaultRegistry.
+//
+// Example:
+//
+//	registry := health.NewRegistry()
+//	registry.Register("payments", paymentsTracker)
+//	registry.Register("jobs", health.QueueDepth(jobs, 0))
+//
+//	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+//	    report := registry.Snapshot()
+//	    if !report.Healthy {
+//	        w.WriteHeader(http.StatusServiceUnavailable)
+//	    }
+//	    json.NewEncoder(w).Encode(report)
+//	})
+type Registry struct {
+	mu         sync.Mutex
+	components map[string]Component
+}
+
+// DefaultRegistry is the Registry used by the package-level Register and Snapshot.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{components: make(map[string]Component)}
+}
+
+// Register adds c under name, replacing any component already registered under it.
+func (r *Registry) Register(name string, c Component) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.components[name] = c
+}
+
+// Unregister removes the component registered under name, if any.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.components, name)
+}
+
+// Snapshot reads the status of every registered component.
+//
+// Behavior:
+//   - Components are read outside the registry lock, so a slow one does not block Register
+//   - A component that panics is reported unhealthy, with the panic in Error
+//   - Nil Maybe fields are replaced with None
+//   - The report is healthy only if every component is; an empty registry is healthy
+func (r *Registry) Snapshot() Report {
+	r.mu.Lock()
+	components := maps.Clone(r.components)
+	r.mu.Unlock()
+
+	report := Report{Healthy: true, Components: make([]Status, 0, len(components))}
+	for _, name := range slices.Sorted(maps.Keys(components)) {
+		status := maybe.Try(func() (Status, error) {
+			return components[name].HealthStatus(), nil
+		}).OrElseGet(func(err error) Status {
+			return Status{Error: err.Error()}
+		})
+		status.Name = name
+		status.FailureRate = orEmpty(status.FailureRate)
+		status.Depth = orEmpty(status.Depth)
+		status.LastSuccess = orEmpty(status.LastSuccess)
+		report.Healthy = report.Healthy && status.Healthy
+		report.Components = append(report.Components, status)
+	}
+	return report
+}
+
+// orEmpty returns m, or None if m is nil.
+func orEmpty[T any](m maybe.Maybe[T]) maybe.Maybe[T] {
+	if m == nil {
+		return maybe.Empty[T]()
+	}
+	return m
+}
+
+// Register adds c to DefaultRegistry. See Registry.Register.
+func Register(name string, c Component) {
+	DefaultRegistry.Register(name, c)
+}
+
+// Snapshot reads DefaultRegistry. See Registry.Snapshot.
+func Snapshot() Report {
+	return DefaultRegistry.Snapshot()
+}
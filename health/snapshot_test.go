@@ -0,0 +1,120 @@
+package health_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/health"
+	"github.com/lonelywolflee/lw-project-fp-go/queue"
+)
+
+func TestRegistry_Snapshot(t *testing.T) {
+	t.Run("is healthy when empty", func(t *testing.T) {
+		report := health.NewRegistry().Snapshot()
+		if !report.Healthy || len(report.Components) != 0 {
+			t.Errorf("expected healthy empty report, got %+v", report)
+		}
+	})
+
+	t.Run("reads every component sorted by name", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(100, 0))
+		tracker := health.NewTracker(health.Config{Clock: fake})
+		tracker.RecordSuccess()
+		jobs := queue.New[string]()
+		jobs.Enqueue("a", 0)
+
+		registry := health.NewRegistry()
+		registry.Register("tracker", tracker)
+		registry.Register("jobs", health.QueueDepth(jobs, 0))
+		report := registry.Snapshot()
+
+		if !report.Healthy || len(report.Components) != 2 {
+			t.Fatalf("expected two healthy components, got %+v", report)
+		}
+		q, tr := report.Components[0], report.Components[1]
+		if q.Name != "jobs" || q.Depth.OrElseDefault(0) != 1 || !q.FailureRate.IsNone() {
+			t.Errorf("unexpected queue status %+v", q)
+		}
+		if tr.Name != "tracker" || tr.FailureRate.OrElseDefault(-1) != 0 || !tr.LastSuccess.OrElseDefault(time.Time{}).Equal(time.Unix(100, 0)) {
+			t.Errorf("unexpected tracker status %+v", tr)
+		}
+	})
+
+	t.Run("is unhealthy when any component is", func(t *testing.T) {
+		jobs := queue.New[int]()
+		jobs.Enqueue(1, 0)
+		jobs.Enqueue(2, 0)
+
+		registry := health.NewRegistry()
+		registry.Register("ok", health.ComponentFunc(func() health.Status { return health.Status{Healthy: true} }))
+		registry.Register("jobs", health.QueueDepth(jobs, 1))
+		if report := registry.Snapshot(); report.Healthy || report.Components[0].Healthy {
+			t.Errorf("expected unhealthy report, got %+v", report)
+		}
+	})
+
+	t.Run("reports panicking component as unhealthy", func(t *testing.T) {
+		registry := health.NewRegistry()
+		registry.Register("broken", health.ComponentFunc(func() health.Status { panic("boom") }))
+
+		report := registry.Snapshot()
+		if report.Healthy || report.Components[0].Name != "broken" || !strings.Contains(report.Components[0].Error, "boom") {
+			t.Errorf("expected unhealthy status with error, got %+v", report)
+		}
+	})
+
+	t.Run("reports unmeasured fields as None", func(t *testing.T) {
+		registry := health.NewRegistry()
+		registry.Register("jobs", health.QueueDepth(queue.New[int](), 0))
+		registry.Register("broken", health.ComponentFunc(func() health.Status { panic("boom") }))
+		registry.Register("bare", health.ComponentFunc(func() health.Status { return health.Status{Healthy: true} }))
+
+		for _, s := range registry.Snapshot().Components {
+			if s.FailureRate.IsSome() || s.LastSuccess.IsSome() {
+				t.Errorf("%s: expected no failure rate or last success, got %+v", s.Name, s)
+			}
+			if s.Name != "jobs" && !s.Depth.IsNone() {
+				t.Errorf("%s: expected no depth, got %v", s.Name, s.Depth)
+			}
+		}
+	})
+
+	t.Run("replaces and removes components", func(t *testing.T) {
+		registry := health.NewRegistry()
+		registry.Register("c", health.ComponentFunc(func() health.Status { return health.Status{} }))
+		registry.Register("c", health.ComponentFunc(func() health.Status { return health.Status{Healthy: true} }))
+		if report := registry.Snapshot(); !report.Healthy || len(report.Components) != 1 {
+			t.Errorf("expected replaced healthy component, got %+v", report)
+		}
+		registry.Unregister("c")
+		if report := registry.Snapshot(); len(report.Components) != 0 {
+			t.Errorf("expected no components, got %+v", report)
+		}
+	})
+
+	t.Run("marshals to JSON with missing fields as null", func(t *testing.T) {
+		registry := health.NewRegistry()
+		registry.Register("jobs", health.QueueDepth(queue.New[int](), 0))
+
+		data, err := json.Marshal(registry.Snapshot())
+		want := `{"healthy":true,"components":[{"name":"jobs","healthy":true,"failure_rate":null,"depth":0,"last_success":null}]}`
+		if err != nil || string(data) != want {
+			t.Errorf("expected %s, got %s (%v)", want, data, err)
+		}
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Run("reads DefaultRegistry", func(t *testing.T) {
+		health.Register("default-test", health.ComponentFunc(func() health.Status { return health.Status{Healthy: true} }))
+		defer health.DefaultRegistry.Unregister("default-test")
+
+		report := health.Snapshot()
+		if len(report.Components) != 1 || report.Components[0].Name != "default-test" {
+			t.Errorf("unexpected report %+v", report)
+		}
+	})
+}
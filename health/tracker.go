@@ -0,0 +1,217 @@
+package health
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Config controls how a Tracker measures and judges the failure rate.
+// Zero numeric fields are replaced by the corresponding DefaultConfig values.
+type Config struct {
+	// Window is the length of the rolling window over which outcomes are counted.
+	Window time.Duration
+	// Buckets is the number of slices the window is divided into; more buckets
+	// make old outcomes age out more smoothly.
+	Buckets int
+	// MaxFailureRate is the highest failure rate, between 0 and 1, still considered healthy.
+	// Zero means unset and selects the default; use ZeroTolerance to make any failure unhealthy.
+	MaxFailureRate float64
+	// MinSamples is the number of outcomes required before the tracker can report unhealthy,
+	// so a single early failure does not flip the state.
+	MinSamples int
+	// Clock is the time source for the window; nil uses clock.System.
+	Clock clock.Clock
+}
+
+// ZeroTolerance is a MaxFailureRate that reports unhealthy as soon as a failure is in the
+// window (once MinSamples is reached). Any negative MaxFailureRate has the same effect.
+const ZeroTolerance = -1.0
+
+// DefaultConfig is used for zero Config fields.
+var DefaultConfig = Config{
+	Window:         time.Minute,
+	Buckets:        10,
+	MaxFailureRate: 0.5,
+	MinSamples:     10,
+}
+
+// Tracker records successes and failures over a rolling time window and reports
+// whether the failure rate is within budget. It is safe for concurrent use.
+//
+// Example:
+//
+//	tracker := health.NewTracker(health.Config{Window: 30 * time.Second, MaxFailureRate: 0.2})
+//	user := health.Observe(tracker, fetchUser(id))
+//	if !tracker.Healthy() {
+//	    // shed load, open a breaker, alert...
+//	}
+type Tracker struct {
+	mu      sync.Mutex
+	cfg     Config
+	width   time.Duration
+	buckets []bucket
+	clock   clock.Clock
+	success time.Time
+}
+
+type bucket struct {
+	epoch     int64
+	successes int
+	failures  int
+}
+
+// NewTracker creates a Tracker, filling zero Config fields from DefaultConfig.
+//
+// Example:
+//
+//	tracker := health.NewTracker(health.Config{}) // DefaultConfig
+func NewTracker(cfg Config) *Tracker {
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultConfig.Window
+	}
+	if cfg.Buckets <= 0 {
+		cfg.Buckets = DefaultConfig.Buckets
+	}
+	switch {
+	case cfg.MaxFailureRate == 0:
+		cfg.MaxFailureRate = DefaultConfig.MaxFailureRate
+	case cfg.MaxFailureRate < 0:
+		cfg.MaxFailureRate = 0
+	}
+	if cfg.MinSamples <= 0 {
+		cfg.MinSamples = DefaultConfig.MinSamples
+	}
+	width := cfg.Window / time.Duration(cfg.Buckets)
+	if width <= 0 {
+		width = 1
+	}
+	return &Tracker{cfg: cfg, width: width, buckets: make([]bucket, cfg.Buckets), clock: clock.OrSystem(cfg.Clock)}
+}
+
+// RecordSuccess counts one successful outcome.
+func (t *Tracker) RecordSuccess() {
+	t.record(false)
+}
+
+// RecordFailure counts one failed outcome.
+func (t *Tracker) RecordFailure() {
+	t.record(true)
+}
+
+func (t *Tracker) record(failed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	epoch := t.epoch(now)
+	n := int64(len(t.buckets))
+	b := &t.buckets[(epoch%n+n)%n] // epochs before 1970 are negative
+	if b.epoch != epoch {
+		*b = bucket{epoch: epoch}
+	}
+	if failed {
+		b.failures++
+	} else {
+		b.successes++
+		t.success = now
+	}
+}
+
+// epoch returns the index of the bucket-wide slice of time containing now. It rounds
+// down, so the slices just before and after the Unix epoch are distinct.
+func (t *Tracker) epoch(now time.Time) int64 {
+	nanos, width := now.UnixNano(), int64(t.width)
+	epoch := nanos / width
+	if nanos%width < 0 {
+		epoch--
+	}
+	return epoch
+}
+
+// counts returns the successes and failures within the window.
+func (t *Tracker) counts() (successes, failures int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	epoch := t.epoch(t.clock.Now())
+	oldest := epoch - int64(len(t.buckets)) + 1
+	for _, b := range t.buckets {
+		if b.epoch >= oldest && b.epoch <= epoch {
+			successes += b.successes
+			failures += b.failures
+		}
+	}
+	return successes, failures
+}
+
+// FailureRate returns the fraction of failed outcomes within the window,
+// or 0 if nothing has been recorded.
+func (t *Tracker) FailureRate() float64 {
+	return failureRate(t.counts())
+}
+
+// Healthy reports whether the failure rate is within MaxFailureRate.
+// The tracker is always healthy until at least MinSamples outcomes are in the window.
+func (t *Tracker) Healthy() bool {
+	return t.healthy(t.counts())
+}
+
+func failureRate(successes, failures int) float64 {
+	total := successes + failures
+	if total == 0 {
+		return 0
+	}
+	return float64(failures) / float64(total)
+}
+
+func (t *Tracker) healthy(successes, failures int) bool {
+	if successes+failures < t.cfg.MinSamples {
+		return true
+	}
+	return failureRate(successes, failures) <= t.cfg.MaxFailureRate
+}
+
+// LastSuccess returns the time of the most recent success, or None if none was recorded.
+// Unlike the failure rate, it does not age out with the window.
+func (t *Tracker) LastSuccess() maybe.Maybe[time.Time] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return maybe.JustIf(!t.success.IsZero(), t.success)
+}
+
+// HealthStatus reports the tracker's health, failure rate and last success,
+// so a Tracker can be registered with a Registry directly. Health and failure rate are
+// derived from a single reading of the window, so they always agree.
+func (t *Tracker) HealthStatus() Status {
+	successes, failures := t.counts()
+	return Status{
+		Healthy:     t.healthy(successes, failures),
+		FailureRate: maybe.Just(failureRate(successes, failures)),
+		LastSuccess: t.LastSuccess(),
+	}
+}
+
+// Observe records the outcome of m on the tracker and returns m unchanged,
+// so it can be dropped into a chain wherever pipeline health should be measured.
+//
+// Behavior:
+//   - Some: recorded as a success
+//   - None: recorded as a success (absence is a valid outcome, not an error)
+//   - Failure: recorded as a failure
+//
+// Example:
+//
+//	result := health.Observe(tracker, maybe.Try(callBackend)).
+//	    Map(normalize)
+func Observe[T any](t *Tracker, m maybe.Maybe[T]) maybe.Maybe[T] {
+	if _, _, err := m.Get(); err != nil {
+		t.RecordFailure()
+	} else {
+		t.RecordSuccess()
+	}
+	return m
+}
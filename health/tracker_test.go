@@ -0,0 +1,234 @@
+package health_test
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/health"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestTracker(t *testing.T) {
+	t.Run("is healthy with no samples", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{})
+
+		if !tracker.Healthy() || tracker.FailureRate() != 0 {
+			t.Error("new tracker should be healthy with zero failure rate")
+		}
+	})
+
+	t.Run("computes failure rate", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{})
+		tracker.RecordSuccess()
+		tracker.RecordSuccess()
+		tracker.RecordSuccess()
+		tracker.RecordFailure()
+
+		if rate := tracker.FailureRate(); math.Abs(rate-0.25) > 1e-9 {
+			t.Errorf("expected 0.25, got %v", rate)
+		}
+	})
+
+	t.Run("stays healthy below MinSamples", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{MinSamples: 5})
+		for i := 0; i < 4; i++ {
+			tracker.RecordFailure()
+		}
+
+		if !tracker.Healthy() {
+			t.Error("tracker should be healthy below MinSamples")
+		}
+		tracker.RecordFailure()
+		if tracker.Healthy() {
+			t.Error("tracker should be unhealthy once MinSamples failures are recorded")
+		}
+	})
+
+	t.Run("compares against MaxFailureRate", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{MinSamples: 1, MaxFailureRate: 0.3})
+		for i := 0; i < 7; i++ {
+			tracker.RecordSuccess()
+		}
+		for i := 0; i < 3; i++ {
+			tracker.RecordFailure()
+		}
+
+		if !tracker.Healthy() {
+			t.Error("failure rate equal to MaxFailureRate should be healthy")
+		}
+		tracker.RecordFailure()
+		if tracker.Healthy() {
+			t.Error("failure rate above MaxFailureRate should be unhealthy")
+		}
+	})
+
+	t.Run("defaults MaxFailureRate only when unset", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{MinSamples: 1})
+		tracker.RecordSuccess()
+		tracker.RecordFailure()
+		if !tracker.Healthy() {
+			t.Error("zero MaxFailureRate should select the default of 0.5")
+		}
+	})
+
+	t.Run("supports zero tolerance", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{MinSamples: 1, MaxFailureRate: health.ZeroTolerance})
+		tracker.RecordSuccess()
+		if !tracker.Healthy() {
+			t.Fatal("tracker without failures should be healthy")
+		}
+		for i := 0; i < 99; i++ {
+			tracker.RecordSuccess()
+		}
+		tracker.RecordFailure()
+		if tracker.Healthy() {
+			t.Error("any failure should be unhealthy with ZeroTolerance")
+		}
+	})
+
+	t.Run("handles times before the Unix epoch", func(t *testing.T) {
+		fake := clock.NewFake(time.Date(1969, time.December, 31, 23, 59, 58, 500_000_000, time.UTC))
+		tracker := health.NewTracker(health.Config{Window: 4 * time.Second, Buckets: 4, MinSamples: 1, Clock: fake})
+		tracker.RecordFailure()
+		if tracker.Healthy() {
+			t.Fatal("tracker should be unhealthy right after a failure")
+		}
+
+		fake.Advance(3 * time.Second)
+		tracker.RecordSuccess()
+		if rate := tracker.FailureRate(); rate != 0.5 {
+			t.Fatalf("failure should still be inside the window, got rate %v", rate)
+		}
+
+		fake.Advance(time.Second)
+		if rate := tracker.FailureRate(); rate != 0 {
+			t.Errorf("failure should age out across the epoch, got rate %v", rate)
+		}
+	})
+
+	t.Run("forgets outcomes outside the window", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		tracker := health.NewTracker(health.Config{Window: 4 * time.Second, Buckets: 4, MinSamples: 1, Clock: fake})
+		tracker.RecordFailure()
+		if tracker.Healthy() {
+			t.Fatal("tracker should be unhealthy right after a failure")
+		}
+
+		fake.Advance(3 * time.Second)
+		if tracker.Healthy() {
+			t.Fatal("failure should still be inside the window")
+		}
+
+		fake.Advance(time.Second)
+		if !tracker.Healthy() || tracker.FailureRate() != 0 {
+			t.Error("old failures should age out of the window")
+		}
+	})
+
+	t.Run("handles windows smaller than the bucket count", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{Window: 1, Buckets: 10})
+		tracker.RecordSuccess()
+		_ = tracker.FailureRate()
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{})
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if i%2 == 0 {
+					tracker.RecordFailure()
+				} else {
+					tracker.RecordSuccess()
+				}
+				tracker.Healthy()
+			}(i)
+		}
+		wg.Wait()
+
+		if rate := tracker.FailureRate(); math.Abs(rate-0.5) > 1e-9 {
+			t.Errorf("expected 0.5, got %v", rate)
+		}
+	})
+}
+
+// tickingClock moves forward by step every time it is read.
+type tickingClock struct {
+	*clock.Fake
+	step time.Duration
+}
+
+func (c tickingClock) Now() time.Time {
+	now := c.Fake.Now()
+	c.Advance(c.step)
+	return now
+}
+
+func TestTracker_HealthStatus(t *testing.T) {
+	t.Run("derives health and failure rate from one reading", func(t *testing.T) {
+		ticking := tickingClock{clock.NewFake(time.Unix(0, 0)), time.Second}
+		tracker := health.NewTracker(health.Config{Window: 2 * time.Second, Buckets: 2, MinSamples: 1, Clock: ticking})
+		tracker.RecordFailure()
+
+		// The failure is still in the window at the next read, but not the one after.
+		status := tracker.HealthStatus()
+		if status.Healthy || status.FailureRate.OrElseDefault(-1) != 1 {
+			t.Errorf("expected an unhealthy status with failure rate 1, got %v and %v", status.Healthy, status.FailureRate)
+		}
+	})
+}
+
+func TestTracker_LastSuccess(t *testing.T) {
+	t.Run("is None before any success", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{})
+		tracker.RecordFailure()
+
+		if !tracker.LastSuccess().IsNone() {
+			t.Error("expected None before any success")
+		}
+	})
+
+	t.Run("keeps the latest success time", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		tracker := health.NewTracker(health.Config{Clock: fake})
+		tracker.RecordSuccess()
+		fake.Advance(time.Hour)
+		tracker.RecordSuccess()
+		fake.Advance(time.Hour)
+		tracker.RecordFailure()
+
+		if got := tracker.LastSuccess().OrPanic(); !got.Equal(time.Unix(3600, 0)) {
+			t.Errorf("expected last success at 1h, got %v", got)
+		}
+	})
+}
+
+func TestObserve(t *testing.T) {
+	t.Run("records outcomes and returns input unchanged", func(t *testing.T) {
+		tracker := health.NewTracker(health.Config{MinSamples: 1})
+		err := errors.New("boom")
+
+		some := health.Observe(tracker, maybe.Maybe[int](maybe.Just(1)))
+		none := health.Observe(tracker, maybe.Maybe[int](maybe.Empty[int]()))
+		failed := health.Observe(tracker, maybe.Maybe[int](maybe.Failed[int](err)))
+
+		if _, ok := some.(maybe.Some[int]); !ok {
+			t.Error("Some should pass through")
+		}
+		if _, ok := none.(maybe.None[int]); !ok {
+			t.Error("None should pass through")
+		}
+		if _, _, e := failed.Get(); e != err {
+			t.Error("Failure should pass through")
+		}
+		if rate := tracker.FailureRate(); math.Abs(rate-1.0/3) > 1e-9 {
+			t.Errorf("expected 1/3 failure rate, got %v", rate)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package idem
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Store records the results of completed effects by idempotency key.
+type Store[V any] interface {
+	// Load returns the recorded result for key and whether one exists.
+	Load(key string) (V, bool, error)
+	// Save records the result for key.
+	Save(key string, value V) error
+}
+
+// Wrap returns a version of fn that applies its effect at most once per idempotency key.
+// keyFn derives the key from the input, for example a request ID or message ID.
+//
+// Behavior:
+//   - If a result is recorded for the key: returns Just(recorded) without calling fn
+//   - Otherwise calls fn; a Some result is recorded and returned
+//   - None and Failure results are not recorded, so a retry calls fn again
+//   - If fn panics: returns Failure with the panic converted to an error
+//   - If the Store fails to load: returns Failure without calling fn
+//   - If the Store fails to save: returns Failure, although the effect has been applied
+//
+// Concurrent calls with the same new key may both call fn; serialize them (for example
+// with a unique constraint in the Store) when that matters.
+//
+// Example:
+//
+//	charge := idem.Wrap(store,
+//	    func(req ChargeRequest) string { return req.IdempotencyKey },
+//	    func(req ChargeRequest) maybe.Maybe[Receipt] { return gateway.Charge(req) },
+//	)
+//
+//	receipt := maybe.FlatMap(request, charge) // retries return the first receipt
+func Wrap[A, B any](store Store[B], keyFn func(A) string, fn func(A) maybe.Maybe[B]) func(A) maybe.Maybe[B] {
+	return func(a A) maybe.Maybe[B] {
+		return maybe.Do(func() maybe.Maybe[B] {
+			key := keyFn(a)
+			recorded, ok, err := store.Load(key)
+			if err != nil {
+				return maybe.Failed[B](fmt.Errorf("idem: load key %q: %w", key, err))
+			}
+			if ok {
+				return maybe.Just(recorded)
+			}
+
+			result := maybe.Do(func() maybe.Maybe[B] { return fn(a) })
+			if value, ok, _ := result.Get(); ok {
+				if err := store.Save(key, value); err != nil {
+					return maybe.Failed[B](fmt.Errorf("idem: effect applied but result for key %q not recorded: %w", key, err))
+				}
+			}
+			return result
+		})
+	}
+}
+
+// MemoryStore is an in-memory Store safe for concurrent use.
+type MemoryStore[V any] struct {
+	mu     sync.Mutex
+	values map[string]V
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore[V any]() *MemoryStore[V] {
+	return &MemoryStore[V]{values: map[string]V{}}
+}
+
+// Load returns the value recorded for key.
+func (s *MemoryStore[V]) Load(key string) (V, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	return v, ok, nil
+}
+
+// Save records value for key.
+func (s *MemoryStore[V]) Save(key string, value V) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+	return nil
+}
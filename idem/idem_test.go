@@ -0,0 +1,101 @@
+package idem_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/idem"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type charge struct {
+	Key    string
+	Amount int
+}
+
+type failingStore struct {
+	loadErr, saveErr error
+}
+
+func (s failingStore) Load(string) (int, bool, error) { return 0, false, s.loadErr }
+func (s failingStore) Save(string, int) error         { return s.saveErr }
+
+func chargeKey(c charge) string { return c.Key }
+
+func TestWrap(t *testing.T) {
+	t.Run("applies the effect once per key", func(t *testing.T) {
+		calls := 0
+		apply := idem.Wrap(idem.NewMemoryStore[int](), chargeKey, func(c charge) maybe.Maybe[int] {
+			calls++
+			return maybe.Just(c.Amount * 100)
+		})
+
+		first, _, _ := apply(charge{Key: "k1", Amount: 5}).Get()
+		replay, _, _ := apply(charge{Key: "k1", Amount: 9}).Get()
+		other, _, _ := apply(charge{Key: "k2", Amount: 1}).Get()
+
+		if first != 500 || replay != 500 || other != 100 {
+			t.Errorf("expected 500, 500, 100, got %d, %d, %d", first, replay, other)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 effect calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not record None or Failure", func(t *testing.T) {
+		store := idem.NewMemoryStore[int]()
+		results := []maybe.Maybe[int]{maybe.Empty[int](), maybe.Failed[int](errors.New("declined")), maybe.Just(7)}
+		calls := 0
+		apply := idem.Wrap(store, chargeKey, func(c charge) maybe.Maybe[int] {
+			r := results[calls]
+			calls++
+			return r
+		})
+
+		apply(charge{Key: "k"})
+		apply(charge{Key: "k"})
+		if v, _, _ := apply(charge{Key: "k"}).Get(); v != 7 || calls != 3 {
+			t.Errorf("expected retries until Some, got %d after %d calls", v, calls)
+		}
+		if v, ok, _ := store.Load("k"); !ok || v != 7 {
+			t.Errorf("expected Some to be recorded, got %d (ok=%v)", v, ok)
+		}
+	})
+
+	t.Run("converts panics to Failure", func(t *testing.T) {
+		apply := idem.Wrap(idem.NewMemoryStore[int](), chargeKey, func(charge) maybe.Maybe[int] { panic("gateway crashed") })
+		if _, ok := apply(charge{Key: "k"}).(maybe.Failure[int]); !ok {
+			t.Error("expected Failure")
+		}
+
+		keyPanics := idem.Wrap(idem.NewMemoryStore[int](), func(charge) string { panic("no key") }, func(charge) maybe.Maybe[int] { return maybe.Just(1) })
+		if _, ok := keyPanics(charge{}).(maybe.Failure[int]); !ok {
+			t.Error("expected Failure when keyFn panics")
+		}
+	})
+
+	t.Run("fails without calling fn when load fails", func(t *testing.T) {
+		loadErr := errors.New("store offline")
+		apply := idem.Wrap[charge, int](failingStore{loadErr: loadErr}, chargeKey, func(charge) maybe.Maybe[int] {
+			t.Error("fn should not be called")
+			return maybe.Just(1)
+		})
+
+		if _, _, err := apply(charge{Key: "k"}).Get(); !errors.Is(err, loadErr) {
+			t.Errorf("expected load error, got %v", err)
+		}
+	})
+
+	t.Run("reports save failure after the effect", func(t *testing.T) {
+		saveErr := errors.New("disk full")
+		applied := false
+		apply := idem.Wrap[charge, int](failingStore{saveErr: saveErr}, chargeKey, func(charge) maybe.Maybe[int] {
+			applied = true
+			return maybe.Just(1)
+		})
+
+		if _, _, err := apply(charge{Key: "k"}).Get(); !errors.Is(err, saveErr) || !applied {
+			t.Errorf("expected save error after effect, got %v (applied=%v)", err, applied)
+		}
+	})
+}
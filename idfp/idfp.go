@@ -0,0 +1,89 @@
+package idfp
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrInvalidUUID is wrapped by the Failure returned when Parse rejects its input.
+var ErrInvalidUUID = errors.New("idfp: invalid UUID")
+
+// UUID is a 128-bit RFC 9562 identifier.
+type UUID [16]byte
+
+// Nil is the all-zero UUID.
+var Nil UUID
+
+// String returns the canonical lowercase form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Parse decodes the canonical 36-character form of a UUID, in either letter case.
+//
+// Behavior:
+//   - If s is "": returns None
+//   - If s is a well-formed UUID: returns Just(uuid)
+//   - Otherwise: returns Failure wrapping ErrInvalidUUID
+//
+// Example:
+//
+//	id := idfp.Parse(r.PathValue("id")) // Just(UUID), None, or Failure
+func Parse(s string) maybe.Maybe[UUID] {
+	if s == "" {
+		return maybe.Empty[UUID]()
+	}
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return maybe.Failed[UUID](fmt.Errorf("%w: %q", ErrInvalidUUID, s))
+	}
+
+	var u UUID
+	src := []byte(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:])
+	if _, err := hex.Decode(u[:], src); err != nil {
+		return maybe.Failed[UUID](fmt.Errorf("%w: %q", ErrInvalidUUID, s))
+	}
+	return maybe.Just(u)
+}
+
+// NewMaybe generates a random (version 4) UUID from crypto/rand.
+//
+// Example:
+//
+//	id, err := idfp.NewMaybe().OrError()
+func NewMaybe() maybe.Maybe[UUID] {
+	return NewFrom(rand.Reader)
+}
+
+// NewFrom generates a random (version 4) UUID from the bytes of r.
+//
+// Behavior:
+//   - If 16 bytes can be read: returns Just(uuid)
+//   - If r fails or runs short: returns Failure with the read error
+//
+// Example:
+//
+//	id := idfp.NewFrom(seededReader) // reproducible IDs in tests
+func NewFrom(r io.Reader) maybe.Maybe[UUID] {
+	var u UUID
+	if _, err := io.ReadFull(r, u[:]); err != nil {
+		return maybe.Failed[UUID](fmt.Errorf("idfp: generate UUID: %w", err))
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return maybe.Just(u)
+}
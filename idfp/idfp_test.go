@@ -0,0 +1,84 @@
+package idfp_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/idfp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("parses canonical form", func(t *testing.T) {
+		const s = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+		u, ok, _ := idfp.Parse(s).Get()
+		if !ok || u.String() != s {
+			t.Errorf("expected %s, got %s", s, u)
+		}
+	})
+
+	t.Run("accepts upper case", func(t *testing.T) {
+		u, ok, _ := idfp.Parse("6BA7B810-9DAD-11D1-80B4-00C04FD430C8").Get()
+		if !ok || u.String() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+			t.Errorf("expected lowercase round trip, got %s", u)
+		}
+	})
+
+	t.Run("returns None for empty string", func(t *testing.T) {
+		if _, ok := idfp.Parse("").(maybe.None[idfp.UUID]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("returns Failure for malformed input", func(t *testing.T) {
+		for _, s := range []string{
+			"6ba7b810",
+			"6ba7b810x9dad-11d1-80b4-00c04fd430c8",
+			"6ba7b810-9dad-11d1-80b4-00c04fd430cz",
+		} {
+			if _, _, err := idfp.Parse(s).Get(); !errors.Is(err, idfp.ErrInvalidUUID) {
+				t.Errorf("%q: expected ErrInvalidUUID, got %v", s, err)
+			}
+		}
+	})
+}
+
+func TestNewMaybe(t *testing.T) {
+	t.Run("generates distinct version 4 UUIDs", func(t *testing.T) {
+		a, _, errA := idfp.NewMaybe().Get()
+		b, _, errB := idfp.NewMaybe().Get()
+		if errA != nil || errB != nil {
+			t.Fatalf("unexpected errors: %v, %v", errA, errB)
+		}
+		if a == b || a == idfp.Nil {
+			t.Errorf("expected distinct non-nil UUIDs, got %s and %s", a, b)
+		}
+		if s := a.String(); s[14] != '4' {
+			t.Errorf("expected version 4, got %s", s)
+		}
+	})
+}
+
+func TestNewFrom(t *testing.T) {
+	t.Run("sets version and variant bits", func(t *testing.T) {
+		u, _, _ := idfp.NewFrom(bytes.NewReader(bytes.Repeat([]byte{0xff}, 16))).Get()
+		if s := u.String(); s != "ffffffff-ffff-4fff-bfff-ffffffffffff" {
+			t.Errorf("unexpected UUID %s", s)
+		}
+	})
+
+	t.Run("returns Failure when reader runs short", func(t *testing.T) {
+		_, _, err := idfp.NewFrom(bytes.NewReader([]byte{1, 2, 3})).Get()
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+		}
+	})
+}
+
+func TestUUID_String(t *testing.T) {
+	if s := idfp.Nil.String(); s != "00000000-0000-0000-0000-000000000000" {
+		t.Errorf("unexpected Nil string %s", s)
+	}
+}
@@ -0,0 +1,53 @@
+package intern
+
+import "sync"
+
+// Table maps equal values to a single canonical copy, so that millions of records sharing a
+// few distinct field values (status codes, country names, tags) also share their memory.
+// The table holds at most capacity distinct values; once full, unseen values pass through
+// unchanged rather than evicting existing entries. A Table is safe for concurrent use.
+//
+// Example:
+//
+//	countries := intern.New[string](1024)
+//
+//	record := maybe.Map(parsed, func(r Record) Record {
+//	    r.Country = countries.Intern(r.Country)
+//	    return r
+//	})
+type Table[T comparable] struct {
+	mu       sync.Mutex
+	values   map[T]T
+	capacity int
+}
+
+// New creates a Table holding at most capacity distinct values.
+// A non-positive capacity creates a Table that never stores anything.
+func New[T comparable](capacity int) *Table[T] {
+	return &Table[T]{values: make(map[T]T, max(capacity, 0)), capacity: capacity}
+}
+
+// Intern returns the canonical copy of v, storing v as the canonical copy if it is new
+// and the table has room. As a func(T) T it can be passed to Some.Map as well as maybe.Map.
+//
+// Example:
+//
+//	status := maybe.Map(field, table.Intern)
+func (t *Table[T]) Intern(v T) T {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if canonical, ok := t.values[v]; ok {
+		return canonical
+	}
+	if len(t.values) < t.capacity {
+		t.values[v] = v
+	}
+	return v
+}
+
+// Len returns the number of distinct values stored.
+func (t *Table[T]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.values)
+}
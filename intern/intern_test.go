@@ -0,0 +1,88 @@
+package intern_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/lonelywolflee/lw-project-fp-go/intern"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// build returns a fresh string with its own backing array.
+func build(parts ...string) string {
+	return strings.Join(parts, "")
+}
+
+func sameMemory(a, b string) bool {
+	return unsafe.StringData(a) == unsafe.StringData(b)
+}
+
+func TestTable_Intern(t *testing.T) {
+	t.Run("returns the canonical copy for equal values", func(t *testing.T) {
+		table := intern.New[string](8)
+		first := table.Intern(build("ac", "tive"))
+		second := table.Intern(build("act", "ive"))
+
+		if first != "active" || !sameMemory(first, second) {
+			t.Error("expected equal strings to share the canonical copy")
+		}
+		if table.Len() != 1 {
+			t.Errorf("expected 1 stored value, got %d", table.Len())
+		}
+	})
+
+	t.Run("passes unseen values through when full", func(t *testing.T) {
+		table := intern.New[string](1)
+		table.Intern("kept")
+		extra := build("ex", "tra")
+
+		if got := table.Intern(extra); !sameMemory(got, extra) {
+			t.Error("expected value to pass through unchanged")
+		}
+		if table.Len() != 1 {
+			t.Errorf("expected table to stay at capacity, got %d", table.Len())
+		}
+		if got := table.Intern("kept"); got != "kept" {
+			t.Errorf("expected existing entry to remain, got %q", got)
+		}
+	})
+
+	t.Run("stores nothing with non-positive capacity", func(t *testing.T) {
+		table := intern.New[int](-1)
+		if table.Intern(7) != 7 || table.Len() != 0 {
+			t.Errorf("expected pass-through with empty table, got len %d", table.Len())
+		}
+	})
+
+	t.Run("works as a Map stage", func(t *testing.T) {
+		table := intern.New[string](4)
+		canonical := table.Intern(build("u", "s"))
+
+		v, _, _ := maybe.Map(maybe.Just(build("u", "s")), table.Intern).Get()
+		if !sameMemory(v, canonical) {
+			t.Error("expected Map stage to return the canonical copy")
+		}
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		table := intern.New[string](16)
+		var wg sync.WaitGroup
+		results := make([]string, 32)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = table.Intern(build("sh", "ared"))
+			}()
+		}
+		wg.Wait()
+
+		for i, r := range results {
+			if !sameMemory(r, results[0]) {
+				t.Errorf("result %d does not share the canonical copy", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,61 @@
+// Package reflectx holds reflection helpers shared by the struct-oriented packages
+// (mapper, patch) for working with fields that hold a Maybe.
+package reflectx
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+// Unwrap reports whether value holds a Maybe and, if so, returns the result of its Get method.
+// A nil interface of a Maybe type is reported as an empty Maybe.
+func Unwrap(value reflect.Value) (v reflect.Value, ok bool, err error, isMaybe bool) {
+	get, found := value.Type().MethodByName("Get")
+	if !found || !isGetMethod(get.Type, value.Kind() == reflect.Interface) {
+		return reflect.Value{}, false, nil, false
+	}
+	if value.Kind() == reflect.Interface && value.IsNil() {
+		return reflect.Value{}, false, nil, true
+	}
+
+	out := value.MethodByName("Get").Call(nil)
+	if e := out[2].Interface(); e != nil {
+		err = e.(error)
+	}
+	return out[0], out[1].Bool(), err, true
+}
+
+// Assign sets target from value. If value is a Maybe, None leaves target untouched,
+// Failure returns its error, and Some assigns either the Maybe itself (when target
+// can hold it) or the wrapped value.
+func Assign(target, value reflect.Value) error {
+	if v, ok, err, isMaybe := Unwrap(value); isMaybe {
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if !value.Type().AssignableTo(target.Type()) {
+			value = v
+		}
+	}
+	if !value.Type().AssignableTo(target.Type()) {
+		return fmt.Errorf("cannot assign %s to %s", value.Type(), target.Type())
+	}
+	target.Set(value)
+	return nil
+}
+
+// isGetMethod reports whether fn has the signature of Maybe.Get: func() (T, bool, error).
+// Method types obtained from concrete types include the receiver as the first input.
+func isGetMethod(fn reflect.Type, isInterface bool) bool {
+	ins := 1
+	if isInterface {
+		ins = 0
+	}
+	return fn.NumIn() == ins && fn.NumOut() == 3 &&
+		fn.Out(1).Kind() == reflect.Bool && fn.Out(2) == errorType
+}
@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/lonelywolflee/lw-project-fp-go/internal/reflectx"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Copy copies the exported fields of src onto a copy of dst, matching fields by name,
+// and returns the updated value. Fields holding a Maybe are treated specially so that
+// a partial update struct can be applied to a domain entity (PATCH semantics).
+//
+// Field rules:
+//   - Maybe field that is None (or a nil interface): dst field is left untouched
+//   - Maybe field that is Some(v): v is assigned to the dst field, or the Maybe itself
+//     if the dst field is a Maybe of the same type
+//   - Maybe field that is Failure: Copy returns Failure with the field's error
+//   - Any other field: its value is assigned to the dst field
+//   - Fields without a dst counterpart are ignored; incompatible types produce Failure
+//
+// Both dst and src must be structs.
+//
+// Example:
+//
+//	type User struct {
+//	    Name  string
+//	    Email string
+//	}
+//	type UserPatch struct {
+//	    Name  maybe.Maybe[string]
+//	    Email maybe.Maybe[string]
+//	}
+//
+//	patch := UserPatch{Name: maybe.Just("Bob"), Email: maybe.Empty[string]()}
+//	updated := mapper.Copy(User{Name: "Alice", Email: "a@example.com"}, patch)
+//	// Just(User{Name: "Bob", Email: "a@example.com"})
+func Copy[D, S any](dst D, src S) maybe.Maybe[D] {
+	return maybe.Do(func() maybe.Maybe[D] {
+		out := reflect.ValueOf(&dst).Elem()
+		in := reflect.ValueOf(src)
+		if out.Kind() != reflect.Struct || in.Kind() != reflect.Struct {
+			return maybe.Failed[D](errors.New("mapper: dst and src must be structs"))
+		}
+
+		for i := 0; i < in.NumField(); i++ {
+			field := in.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			target := out.FieldByName(field.Name)
+			if !target.IsValid() || !target.CanSet() {
+				continue
+			}
+			if err := reflectx.Assign(target, in.Field(i)); err != nil {
+				return maybe.Failed[D](fmt.Errorf("mapper: field %s: %w", field.Name, err))
+			}
+		}
+		return maybe.Just(dst)
+	})
+}
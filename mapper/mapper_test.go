@@ -0,0 +1,155 @@
+package mapper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/mapper"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type User struct {
+	Name  string
+	Email string
+	Age   int
+	Tags  []string
+}
+
+type UserPatch struct {
+	Name  maybe.Maybe[string]
+	Email maybe.Maybe[string]
+	Age   maybe.Maybe[int]
+}
+
+func TestCopy(t *testing.T) {
+	base := User{Name: "Alice", Email: "alice@example.com", Age: 30}
+
+	t.Run("applies Some fields and keeps None fields", func(t *testing.T) {
+		patch := UserPatch{
+			Name:  maybe.Just("Bob"),
+			Email: maybe.Empty[string](),
+			Age:   maybe.Just(31),
+		}
+
+		user, ok, err := mapper.Copy(base, patch).Get()
+		if err != nil || !ok {
+			t.Fatalf("expected Some, got ok=%v err=%v", ok, err)
+		}
+		want := User{Name: "Bob", Email: "alice@example.com", Age: 31}
+		if user.Name != want.Name || user.Email != want.Email || user.Age != want.Age {
+			t.Errorf("expected %+v, got %+v", want, user)
+		}
+	})
+
+	t.Run("treats nil Maybe fields as None", func(t *testing.T) {
+		user, _, _ := mapper.Copy(base, UserPatch{Age: maybe.Just(40)}).Get()
+		if user.Name != "Alice" || user.Email != "alice@example.com" || user.Age != 40 {
+			t.Errorf("unexpected result %+v", user)
+		}
+	})
+
+	t.Run("does not modify the original dst", func(t *testing.T) {
+		original := base
+		mapper.Copy(original, UserPatch{Name: maybe.Just("Bob")})
+		if original.Name != "Alice" {
+			t.Errorf("original should be untouched, got %+v", original)
+		}
+	})
+
+	t.Run("returns Failure for Failure fields", func(t *testing.T) {
+		fieldErr := errors.New("invalid email")
+		result := mapper.Copy(base, UserPatch{Email: maybe.Failed[string](fieldErr)})
+
+		_, _, err := result.Get()
+		if !errors.Is(err, fieldErr) {
+			t.Errorf("expected field error, got %v", err)
+		}
+	})
+
+	t.Run("copies plain fields", func(t *testing.T) {
+		type Source struct {
+			Name string
+			Tags []string
+		}
+		user, _, _ := mapper.Copy(base, Source{Name: "Carol", Tags: []string{"admin"}}).Get()
+		if user.Name != "Carol" || len(user.Tags) != 1 || user.Tags[0] != "admin" {
+			t.Errorf("unexpected result %+v", user)
+		}
+	})
+
+	t.Run("copies Maybe fields into Maybe fields", func(t *testing.T) {
+		type Target struct {
+			Name maybe.Maybe[string]
+		}
+		result, _, _ := mapper.Copy(Target{Name: maybe.Just("old")}, UserPatch{Name: maybe.Just("new")}).Get()
+		if v, _, _ := result.Name.Get(); v != "new" {
+			t.Errorf("expected Just(new), got %v", result.Name)
+		}
+	})
+
+	t.Run("supports concrete Some and None field types", func(t *testing.T) {
+		type Source struct {
+			Name maybe.Some[string]
+			Age  maybe.None[int]
+		}
+		user, _, _ := mapper.Copy(base, Source{Name: maybe.Just("Dave")}).Get()
+		if user.Name != "Dave" || user.Age != 30 {
+			t.Errorf("unexpected result %+v", user)
+		}
+	})
+
+	t.Run("ignores fields without counterpart and unexported fields", func(t *testing.T) {
+		type Source struct {
+			Nickname string
+			name     string
+		}
+		user, ok, err := mapper.Copy(base, Source{Nickname: "Al", name: "x"}).Get()
+		if err != nil || !ok || user.Name != "Alice" {
+			t.Errorf("unexpected result %+v, ok=%v, err=%v", user, ok, err)
+		}
+	})
+
+	t.Run("returns Failure for incompatible field types", func(t *testing.T) {
+		type Source struct {
+			Age maybe.Maybe[string]
+		}
+		if _, ok := mapper.Copy(base, Source{Age: maybe.Just("old")}).(maybe.Failure[User]); !ok {
+			t.Fatal("expected Failure for incompatible types")
+		}
+
+		type Plain struct {
+			Age string
+		}
+		if _, ok := mapper.Copy(base, Plain{Age: "old"}).(maybe.Failure[User]); !ok {
+			t.Fatal("expected Failure for incompatible plain types")
+		}
+	})
+
+	t.Run("returns Failure for non-struct arguments", func(t *testing.T) {
+		if _, ok := mapper.Copy(base, 42).(maybe.Failure[User]); !ok {
+			t.Fatal("expected Failure for non-struct src")
+		}
+		if _, ok := mapper.Copy(&base, UserPatch{}).(maybe.Failure[*User]); !ok {
+			t.Fatal("expected Failure for non-struct dst")
+		}
+	})
+
+	t.Run("treats non-Maybe Get methods as plain values", func(t *testing.T) {
+		type Source struct {
+			Name getter
+		}
+		type Target struct {
+			Name getter
+		}
+		result, ok, err := mapper.Copy(Target{}, Source{Name: getter{"x"}}).Get()
+		if err != nil || !ok || result.Name.v != "x" {
+			t.Errorf("unexpected result %+v, ok=%v, err=%v", result, ok, err)
+		}
+	})
+}
+
+type getter struct {
+	v string
+}
+
+func (g getter) Get() string { return g.v }
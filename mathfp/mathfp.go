@@ -0,0 +1,78 @@
+package mathfp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+var (
+	// ErrOverflow is wrapped by the Failure returned when a result does not fit in an int64.
+	ErrOverflow = errors.New("mathfp: integer overflow")
+	// ErrDivideByZero is wrapped by the Failure returned by DivMaybe for a zero divisor.
+	ErrDivideByZero = errors.New("mathfp: division by zero")
+)
+
+// AddInt64 returns a + b, or a Failure wrapping ErrOverflow instead of wrapping around.
+//
+// Example:
+//
+//	total := mathfp.AddInt64(balanceCents, depositCents)
+func AddInt64(a, b int64) maybe.Maybe[int64] {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return maybe.Failed[int64](fmt.Errorf("%w: %d + %d", ErrOverflow, a, b))
+	}
+	return maybe.Just(sum)
+}
+
+// SubInt64 returns a - b, or a Failure wrapping ErrOverflow instead of wrapping around.
+//
+// Example:
+//
+//	remaining := mathfp.SubInt64(balanceCents, withdrawalCents)
+func SubInt64(a, b int64) maybe.Maybe[int64] {
+	diff := a - b
+	if (b > 0 && diff > a) || (b < 0 && diff < a) {
+		return maybe.Failed[int64](fmt.Errorf("%w: %d - %d", ErrOverflow, a, b))
+	}
+	return maybe.Just(diff)
+}
+
+// MulInt64 returns a * b, or a Failure wrapping ErrOverflow instead of wrapping around.
+//
+// Example:
+//
+//	lineTotal := mathfp.MulInt64(unitPriceCents, quantity)
+func MulInt64(a, b int64) maybe.Maybe[int64] {
+	if a == 0 || b == 0 {
+		return maybe.Just[int64](0)
+	}
+	product := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || product/b != a {
+		return maybe.Failed[int64](fmt.Errorf("%w: %d * %d", ErrOverflow, a, b))
+	}
+	return maybe.Just(product)
+}
+
+// DivMaybe returns a / b truncated toward zero.
+//
+// Behavior:
+//   - If b is 0: returns Failure wrapping ErrDivideByZero
+//   - If a is math.MinInt64 and b is -1: returns Failure wrapping ErrOverflow
+//   - Otherwise: returns Just(a / b)
+//
+// Example:
+//
+//	perPerson := mathfp.DivMaybe(billCents, int64(len(people)))
+func DivMaybe(a, b int64) maybe.Maybe[int64] {
+	if b == 0 {
+		return maybe.Failed[int64](fmt.Errorf("%w: %d / 0", ErrDivideByZero, a))
+	}
+	if a == math.MinInt64 && b == -1 {
+		return maybe.Failed[int64](fmt.Errorf("%w: %d / %d", ErrOverflow, a, b))
+	}
+	return maybe.Just(a / b)
+}
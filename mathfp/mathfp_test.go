@@ -0,0 +1,75 @@
+package mathfp_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/mathfp"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type binaryCase struct {
+	a, b int64
+	want int64
+	err  error
+}
+
+func check(t *testing.T, op string, fn func(a, b int64) maybe.Maybe[int64], cases []binaryCase) {
+	t.Helper()
+	for _, c := range cases {
+		v, _, err := fn(c.a, c.b).Get()
+		if c.err != nil {
+			if !errors.Is(err, c.err) {
+				t.Errorf("%d %s %d: expected %v, got %v", c.a, op, c.b, c.err, err)
+			}
+			continue
+		}
+		if err != nil || v != c.want {
+			t.Errorf("%d %s %d: expected %d, got %d (err=%v)", c.a, op, c.b, c.want, v, err)
+		}
+	}
+}
+
+func TestAddInt64(t *testing.T) {
+	check(t, "+", mathfp.AddInt64, []binaryCase{
+		{a: 2, b: 3, want: 5},
+		{a: -2, b: -3, want: -5},
+		{a: math.MaxInt64, b: -1, want: math.MaxInt64 - 1},
+		{a: math.MaxInt64, b: 1, err: mathfp.ErrOverflow},
+		{a: math.MinInt64, b: -1, err: mathfp.ErrOverflow},
+	})
+}
+
+func TestSubInt64(t *testing.T) {
+	check(t, "-", mathfp.SubInt64, []binaryCase{
+		{a: 5, b: 3, want: 2},
+		{a: -5, b: -3, want: -2},
+		{a: math.MinInt64, b: 1, err: mathfp.ErrOverflow},
+		{a: math.MaxInt64, b: -1, err: mathfp.ErrOverflow},
+		{a: 0, b: math.MinInt64, err: mathfp.ErrOverflow},
+	})
+}
+
+func TestMulInt64(t *testing.T) {
+	check(t, "*", mathfp.MulInt64, []binaryCase{
+		{a: 6, b: 7, want: 42},
+		{a: -6, b: 7, want: -42},
+		{a: 0, b: math.MinInt64, want: 0},
+		{a: math.MinInt64, b: 1, want: math.MinInt64},
+		{a: math.MaxInt64, b: 2, err: mathfp.ErrOverflow},
+		{a: math.MinInt64, b: -1, err: mathfp.ErrOverflow},
+		{a: -1, b: math.MinInt64, err: mathfp.ErrOverflow},
+		{a: 1 << 32, b: 1 << 32, err: mathfp.ErrOverflow},
+	})
+}
+
+func TestDivMaybe(t *testing.T) {
+	check(t, "/", mathfp.DivMaybe, []binaryCase{
+		{a: 7, b: 2, want: 3},
+		{a: -7, b: 2, want: -3},
+		{a: math.MinInt64, b: 1, want: math.MinInt64},
+		{a: 1, b: 0, err: mathfp.ErrDivideByZero},
+		{a: math.MinInt64, b: -1, err: mathfp.ErrOverflow},
+	})
+}
@@ -0,0 +1,59 @@
+package maybe
+
+// IsTrue reports whether m is Some(true).
+// None and Failure are neither true nor false, so they return false.
+//
+// Example:
+//
+//	if IsTrue(flags.DarkMode) {
+//	    theme = dark
+//	}
+func IsTrue(m Maybe[bool]) bool {
+	v, ok, _ := m.Get()
+	return ok && v
+}
+
+// IsFalse reports whether m is Some(false), an explicit opt-out rather than an unset value.
+//
+// Example:
+//
+//	if IsFalse(prefs.Emails) {
+//	    skipNewsletter()
+//	}
+func IsFalse(m Maybe[bool]) bool {
+	v, ok, _ := m.Get()
+	return ok && !v
+}
+
+// IsUnset reports whether m is None. A Failure is not unset: the value was provided but
+// could not be read.
+//
+// Example:
+//
+//	if IsUnset(req.IncludeArchived) {
+//	    req.IncludeArchived = Just(defaultIncludeArchived)
+//	}
+func IsUnset(m Maybe[bool]) bool {
+	_, ok, err := m.Get()
+	return !ok && err == nil
+}
+
+// FirstSet merges tri-state booleans by precedence: it returns the first m that is not None,
+// so layers are listed from most to least specific.
+//
+// Behavior:
+//   - Returns the first Some or Failure in order
+//   - Returns None if every m is None or no m is given
+//
+// Example:
+//
+//	enabled := FirstSet(userOverride, teamSetting, globalDefault)
+//	// userOverride wins when set; otherwise teamSetting, then globalDefault
+func FirstSet(ms ...Maybe[bool]) Maybe[bool] {
+	for _, m := range ms {
+		if !IsUnset(m) {
+			return m
+		}
+	}
+	return Empty[bool]()
+}
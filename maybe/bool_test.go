@@ -0,0 +1,96 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+var (
+	boolTrue    maybe.Maybe[bool] = maybe.Just(true)
+	boolFalse   maybe.Maybe[bool] = maybe.Just(false)
+	boolUnset   maybe.Maybe[bool] = maybe.Empty[bool]()
+	boolFailure maybe.Maybe[bool] = maybe.Failed[bool](errors.New("unreadable"))
+)
+
+func TestIsTrue(t *testing.T) {
+	cases := map[string]struct {
+		m    maybe.Maybe[bool]
+		want bool
+	}{
+		"true":    {boolTrue, true},
+		"false":   {boolFalse, false},
+		"unset":   {boolUnset, false},
+		"failure": {boolFailure, false},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := maybe.IsTrue(c.m); got != c.want {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestIsFalse(t *testing.T) {
+	cases := map[string]struct {
+		m    maybe.Maybe[bool]
+		want bool
+	}{
+		"true":    {boolTrue, false},
+		"false":   {boolFalse, true},
+		"unset":   {boolUnset, false},
+		"failure": {boolFailure, false},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := maybe.IsFalse(c.m); got != c.want {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+// customNone is a user-defined Maybe in the None state that is not the built-in None type.
+type customNone struct{ maybe.None[bool] }
+
+func TestIsUnset(t *testing.T) {
+	cases := map[string]struct {
+		m    maybe.Maybe[bool]
+		want bool
+	}{
+		"true":    {boolTrue, false},
+		"false":   {boolFalse, false},
+		"unset":   {boolUnset, true},
+		"failure": {boolFailure, false},
+		"custom":  {customNone{}, true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := maybe.IsUnset(c.m); got != c.want {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestFirstSet(t *testing.T) {
+	t.Run("returns first explicit value", func(t *testing.T) {
+		if got := maybe.FirstSet(boolUnset, boolFalse, boolTrue); !maybe.IsFalse(got) {
+			t.Errorf("expected Just(false), got %v", got)
+		}
+	})
+
+	t.Run("returns Failure when it comes first", func(t *testing.T) {
+		if got := maybe.FirstSet(boolUnset, boolFailure, boolTrue); got != boolFailure {
+			t.Errorf("expected Failure, got %v", got)
+		}
+	})
+
+	t.Run("returns None when nothing is set", func(t *testing.T) {
+		if !maybe.IsUnset(maybe.FirstSet(boolUnset, boolUnset)) || !maybe.IsUnset(maybe.FirstSet()) {
+			t.Error("expected None")
+		}
+	})
+}
@@ -35,3 +35,65 @@ func Empty[T any]() None[T] {
 func Failed[T any](e error) Failure[T] {
 	return Failure[T]{e: e}
 }
+
+// JustAll wraps every element of vs in Some, in order.
+// The result slice is allocated once at its final size, so bulk conversion avoids the
+// repeated growth of an append loop. Each element still costs one allocation when it is
+// boxed into the Maybe interface, except for pointer-shaped T.
+//
+// Example:
+//
+//	values := JustAll([]int{1, 2, 3}) // []Maybe[int]{Just(1), Just(2), Just(3)}
+func JustAll[T any](vs []T) []Maybe[T] {
+	out := make([]Maybe[T], len(vs))
+	for i, v := range vs {
+		out[i] = Some[T]{v: v}
+	}
+	return out
+}
+
+// FailedWithCode creates a Failure whose error is a *CodedError carrying a message key and
+// its arguments. The metadata survives wrapping and can be read back with CodeOf.
+//
+// Example:
+//
+//	maybe := FailedWithCode[User]("user.not_found", userID)
+//	_, _, err := maybe.Get() // err.Error() == "user.not_found [42]"
+func FailedWithCode[T any](code string, args ...any) Failure[T] {
+	return Failure[T]{e: &CodedError{Code: code, Args: args}}
+}
+
+// JustIf returns Just(v) when cond holds and None otherwise.
+// Note that v is evaluated either way; use When if computing it is expensive or unsafe
+// when the condition is false.
+//
+// Example:
+//
+//	discount := JustIf(user.IsPremium, 0.1) // Just(0.1) for premium users, None otherwise
+func JustIf[T any](cond bool, v T) Maybe[T] {
+	if !cond {
+		return Empty[T]()
+	}
+	return Just(v)
+}
+
+// When returns Just(fn()) when cond holds and None otherwise, calling fn only if needed.
+//
+// Behavior:
+//   - If cond is false: returns None (fn not called)
+//   - If cond is true: returns Just(fn())
+//   - If fn panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	report := When(req.IncludeStats, func() Stats {
+//	    return computeStats(orders) // only computed when requested
+//	})
+func When[T any](cond bool, fn func() T) Maybe[T] {
+	if !cond {
+		return Empty[T]()
+	}
+	return Do(func() Maybe[T] {
+		return Just(fn())
+	})
+}
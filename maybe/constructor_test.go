@@ -67,3 +67,122 @@ func TestFail(t *testing.T) {
 		}
 	})
 }
+
+func TestJustAll(t *testing.T) {
+	t.Run("wraps every value in Some", func(t *testing.T) {
+		result := maybe.JustAll([]string{"a", "b", "c"})
+		if len(result) != 3 {
+			t.Fatalf("expected 3 values, got %d", len(result))
+		}
+		for i, want := range []string{"a", "b", "c"} {
+			if v, ok := result[i].(maybe.Some[string]); !ok || v.OrPanic() != want {
+				t.Errorf("index %d: expected Just(%s), got %v", i, want, result[i])
+			}
+		}
+	})
+
+	t.Run("returns empty slice for empty input", func(t *testing.T) {
+		result := maybe.JustAll[int](nil)
+		if result == nil || len(result) != 0 {
+			t.Errorf("expected empty non-nil slice, got %v", result)
+		}
+	})
+}
+
+var benchValues = func() []int {
+	vs := make([]int, 1024)
+	for i := range vs {
+		vs[i] = i + 1000
+	}
+	return vs
+}()
+
+func BenchmarkJustAll(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = maybe.JustAll(benchValues)
+	}
+}
+
+func BenchmarkJustAppendLoop(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var out []maybe.Maybe[int]
+		for _, v := range benchValues {
+			out = append(out, maybe.Just(v))
+		}
+		_ = out
+	}
+}
+
+func BenchmarkJustAllPointers(b *testing.B) {
+	ptrs := make([]*int, len(benchValues))
+	for i := range benchValues {
+		ptrs[i] = &benchValues[i]
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = maybe.JustAll(ptrs)
+	}
+}
+
+func TestFailedWithCode(t *testing.T) {
+	t.Run("creates Failure carrying code and args", func(t *testing.T) {
+		_, _, err := maybe.FailedWithCode[int]("user.not_found", 42, "eu").Get()
+
+		var coded *maybe.CodedError
+		if !errors.As(err, &coded) {
+			t.Fatalf("expected *CodedError, got %T", err)
+		}
+		if coded.Code != "user.not_found" || len(coded.Args) != 2 || coded.Args[0] != 42 {
+			t.Errorf("unexpected metadata %+v", coded)
+		}
+	})
+
+	t.Run("formats error message", func(t *testing.T) {
+		_, _, withArgs := maybe.FailedWithCode[int]("user.not_found", 42).Get()
+		_, _, noArgs := maybe.FailedWithCode[int]("quota.exceeded").Get()
+
+		if withArgs.Error() != "user.not_found [42]" {
+			t.Errorf("unexpected message %q", withArgs.Error())
+		}
+		if noArgs.Error() != "quota.exceeded" {
+			t.Errorf("unexpected message %q", noArgs.Error())
+		}
+	})
+}
+
+func TestJustIf(t *testing.T) {
+	t.Run("returns Some when condition holds", func(t *testing.T) {
+		if v, ok, _ := maybe.JustIf(true, 0.1).Get(); !ok || v != 0.1 {
+			t.Errorf("expected Just(0.1), got %v %v", v, ok)
+		}
+	})
+
+	t.Run("returns None when condition fails", func(t *testing.T) {
+		if m := maybe.JustIf(false, 0.1); !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+	})
+}
+
+func TestWhen(t *testing.T) {
+	t.Run("calls fn and returns Some when condition holds", func(t *testing.T) {
+		if v, ok, _ := maybe.When(true, func() int { return 7 }).Get(); !ok || v != 7 {
+			t.Errorf("expected Just(7), got %v %v", v, ok)
+		}
+	})
+
+	t.Run("returns None without calling fn when condition fails", func(t *testing.T) {
+		m := maybe.When(false, func() int { t.Error("fn should not be called"); return 0 })
+		if !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		if m := maybe.When(true, func() int { panic("boom") }); !m.IsFailed() {
+			t.Errorf("expected Failure, got %v", m)
+		}
+	})
+}
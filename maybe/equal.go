@@ -0,0 +1,52 @@
+package maybe
+
+import "errors"
+
+// Equal reports whether a and b are in the same state and hold equal contents.
+// It is EqualFunc with the == operator.
+//
+// Example:
+//
+//	Equal[int](Just(1), Just(1))                               // true
+//	Equal[int](Just(1), Empty[int]())                          // false
+//	Equal[int](Failed[int](wrapped), Failed[int](ErrNotFound)) // true if wrapped wraps ErrNotFound
+func Equal[T comparable](a, b Maybe[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// Contains reports whether m is Some holding a value equal to v.
+// None and Failure never contain a value.
+//
+// Example:
+//
+//	Contains[string](Just("admin"), "admin")   // true
+//	Contains[string](Empty[string](), "admin") // false
+func Contains[T comparable](m Maybe[T], v T) bool {
+	return m.Exists(func(x T) bool { return x == v })
+}
+
+// EqualFunc reports whether a and b are in the same state and hold equal contents,
+// comparing values with eq. It is useful in tests and for types that are not comparable.
+//
+// Behavior:
+//   - Some and Some: equal if eq(a, b) is true
+//   - None and None: always equal
+//   - Failure and Failure: equal if either error matches the other with errors.Is,
+//     so a wrapped error equals the sentinel it wraps, whichever side it is on
+//   - Different states: never equal
+//
+// Example:
+//
+//	sameUser := EqualFunc(got, want, func(a, b User) bool { return a.ID == b.ID })
+func EqualFunc[T any](a, b Maybe[T], eq func(T, T) bool) bool {
+	av, aOk, aErr := a.Get()
+	bv, bOk, bErr := b.Get()
+	switch {
+	case aErr != nil || bErr != nil:
+		return aErr != nil && bErr != nil && (errors.Is(aErr, bErr) || errors.Is(bErr, aErr))
+	case aOk && bOk:
+		return eq(av, bv)
+	default:
+		return aOk == bOk
+	}
+}
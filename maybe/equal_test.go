@@ -0,0 +1,78 @@
+package maybe_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestEqual(t *testing.T) {
+	errNotFound := errors.New("not found")
+	wrapped := fmt.Errorf("load user: %w", errNotFound)
+
+	cases := []struct {
+		name string
+		a, b maybe.Maybe[int]
+		want bool
+	}{
+		{"equal Somes", maybe.Just(1), maybe.Just(1), true},
+		{"different Somes", maybe.Just(1), maybe.Just(2), false},
+		{"two Nones", maybe.Empty[int](), maybe.Empty[int](), true},
+		{"same error", maybe.Failed[int](errNotFound), maybe.Failed[int](errNotFound), true},
+		{"wrapped error on the left", maybe.Failed[int](wrapped), maybe.Failed[int](errNotFound), true},
+		{"wrapped error on the right", maybe.Failed[int](errNotFound), maybe.Failed[int](wrapped), true},
+		{"unrelated errors", maybe.Failed[int](errNotFound), maybe.Failed[int](errors.New("timeout")), false},
+		{"Some and None", maybe.Just(0), maybe.Empty[int](), false},
+		{"None and Some", maybe.Empty[int](), maybe.Just(0), false},
+		{"Some and Failure", maybe.Just(0), maybe.Failed[int](errNotFound), false},
+		{"Failure and None", maybe.Failed[int](errNotFound), maybe.Empty[int](), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := maybe.Equal(c.a, c.b); got != c.want {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	cases := []struct {
+		name string
+		m    maybe.Maybe[string]
+		want bool
+	}{
+		{"Some with equal value", maybe.Just("admin"), true},
+		{"Some with other value", maybe.Just("guest"), false},
+		{"None", maybe.Empty[string](), false},
+		{"Failure", maybe.Failed[string](errors.New("boom")), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := maybe.Contains(c.m, "admin"); got != c.want {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	t.Run("compares non-comparable values with eq", func(t *testing.T) {
+		a := maybe.Just([]int{1, 2})
+		if !maybe.EqualFunc[[]int](a, maybe.Just([]int{1, 2}), slices.Equal) {
+			t.Error("expected equal slices to compare equal")
+		}
+		if maybe.EqualFunc[[]int](a, maybe.Just([]int{1}), slices.Equal) {
+			t.Error("expected different slices to compare unequal")
+		}
+	})
+
+	t.Run("does not call eq unless both are Some", func(t *testing.T) {
+		eq := func(a, b []int) bool { t.Error("eq should not be called"); return false }
+		maybe.EqualFunc[[]int](maybe.Empty[[]int](), maybe.Just([]int{1}), eq)
+		maybe.EqualFunc[[]int](maybe.Failed[[]int](errors.New("x")), maybe.Just([]int{1}), eq)
+	})
+}
@@ -0,0 +1,105 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CodedError is an error identified by a stable message key and its arguments rather than
+// by its text. API layers can look the code up in a translation catalog and format the
+// arguments for the caller's locale instead of parsing error strings.
+//
+// Create one with FailedWithCode and retrieve it later with CodeOf.
+type CodedError struct {
+	Code string
+	Args []any
+}
+
+// Error returns the code, followed by the arguments when there are any.
+func (e *CodedError) Error() string {
+	if len(e.Args) == 0 {
+		return e.Code
+	}
+	return fmt.Sprintf("%s %v", e.Code, e.Args)
+}
+
+// InvariantError reports a violated invariant detected by Assert. It signals a bug rather
+// than bad input, so callers should log and alert on it instead of showing it to users.
+type InvariantError struct {
+	Msg string
+}
+
+// Error returns the invariant message.
+func (e *InvariantError) Error() string {
+	return "invariant violated: " + e.Msg
+}
+
+// CodeOf returns the first CodedError in the error chain of a Failure.
+//
+// Behavior:
+//   - If m is Failure and its error chain contains a *CodedError: returns Just(codedErr)
+//   - Otherwise (Some, None, or an uncoded Failure): returns None
+//
+// Example:
+//
+//	result := findUser(id) // FailedWithCode[User]("user.not_found", id)
+//
+//	if coded, ok, _ := CodeOf(result).Get(); ok {
+//	    msg := catalog.Translate(lang, coded.Code, coded.Args...)
+//	}
+func CodeOf[T any](m Maybe[T]) Maybe[*CodedError] {
+	if _, _, err := m.Get(); err != nil {
+		var coded *CodedError
+		if errors.As(err, &coded) {
+			return Just(coded)
+		}
+	}
+	return Empty[*CodedError]()
+}
+
+// ErrAs finds the first error in a Failure's error chain that matches E, as errors.As does.
+//
+// Behavior:
+//   - If m is Failure and its error chain contains an E: returns Just(e)
+//   - Otherwise (Some, None, or no match): returns None
+//
+// Example:
+//
+//	if pathErr, ok, _ := ErrAs[*fs.PathError](readConfig()).Get(); ok {
+//	    log.Printf("missing file %s", pathErr.Path)
+//	}
+func ErrAs[E error, T any](m Maybe[T]) Maybe[E] {
+	if _, _, err := m.Get(); err != nil {
+		var target E
+		if errors.As(err, &target) {
+			return Just(target)
+		}
+	}
+	return Empty[E]()
+}
+
+// RootCause follows the Unwrap chain of a Failure's error down to the innermost error.
+// Errors that wrap several errors (such as errors.Join) have no single cause, so the walk
+// stops at them and returns that error.
+//
+// Behavior:
+//   - If m is Failure: returns Just(innermost error)
+//   - If m is Some or None: returns None
+//
+// Example:
+//
+//	result := Failed[int](fmt.Errorf("handler: %w", fmt.Errorf("query: %w", sql.ErrConnDone)))
+//	cause := RootCause(result) // Just(sql.ErrConnDone)
+func RootCause[T any](m Maybe[T]) Maybe[error] {
+	_, _, err := m.Get()
+	if err == nil {
+		return Empty[error]()
+	}
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return Just(err)
+		}
+		err = next
+	}
+}
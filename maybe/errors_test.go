@@ -0,0 +1,105 @@
+package maybe_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestCodeOf(t *testing.T) {
+	t.Run("returns code of coded Failure", func(t *testing.T) {
+		result := maybe.CodeOf[int](maybe.FailedWithCode[int]("user.not_found", 7))
+
+		coded, ok, _ := result.Get()
+		if !ok || coded.Code != "user.not_found" || coded.Args[0] != 7 {
+			t.Errorf("expected user.not_found code, got %+v", coded)
+		}
+	})
+
+	t.Run("finds code through wrapping", func(t *testing.T) {
+		_, _, inner := maybe.FailedWithCode[int]("db.timeout").Get()
+		wrapped := maybe.Failed[int](fmt.Errorf("load profile: %w", inner))
+
+		if coded, ok, _ := maybe.CodeOf[int](wrapped).Get(); !ok || coded.Code != "db.timeout" {
+			t.Errorf("expected db.timeout code, got %+v", coded)
+		}
+	})
+
+	t.Run("returns None without a code", func(t *testing.T) {
+		cases := []maybe.Maybe[int]{
+			maybe.Just(1),
+			maybe.Empty[int](),
+			maybe.Failed[int](errors.New("plain")),
+		}
+		for i, m := range cases {
+			if _, ok := maybe.CodeOf(m).(maybe.None[*maybe.CodedError]); !ok {
+				t.Errorf("case %d: expected None", i)
+			}
+		}
+	})
+}
+
+type codeError struct{ code int }
+
+func (e *codeError) Error() string { return fmt.Sprintf("code %d", e.code) }
+
+func TestErrAs(t *testing.T) {
+	t.Run("finds matching error in chain", func(t *testing.T) {
+		m := maybe.Failed[string](fmt.Errorf("request: %w", &codeError{code: 503}))
+
+		target, ok, _ := maybe.ErrAs[*codeError](m).Get()
+		if !ok || target.code != 503 {
+			t.Errorf("expected code 503, got %v", target)
+		}
+	})
+
+	t.Run("returns None without a match", func(t *testing.T) {
+		cases := []maybe.Maybe[string]{
+			maybe.Just("ok"),
+			maybe.Empty[string](),
+			maybe.Failed[string](errors.New("plain")),
+		}
+		for i, m := range cases {
+			if _, ok := maybe.ErrAs[*codeError](m).(maybe.None[*codeError]); !ok {
+				t.Errorf("case %d: expected None", i)
+			}
+		}
+	})
+}
+
+func TestRootCause(t *testing.T) {
+	root := errors.New("connection refused")
+
+	t.Run("returns innermost error", func(t *testing.T) {
+		m := maybe.Failed[int](fmt.Errorf("handler: %w", fmt.Errorf("query: %w", root)))
+
+		if cause, ok, _ := maybe.RootCause(m).Get(); !ok || cause != root {
+			t.Errorf("expected root cause, got %v", cause)
+		}
+	})
+
+	t.Run("returns unwrapped error itself", func(t *testing.T) {
+		if cause, _, _ := maybe.RootCause[int](maybe.Failed[int](root)).Get(); cause != root {
+			t.Errorf("expected root cause, got %v", cause)
+		}
+	})
+
+	t.Run("stops at joined errors", func(t *testing.T) {
+		joined := errors.Join(root, errors.New("other"))
+		m := maybe.Failed[int](fmt.Errorf("batch: %w", joined))
+
+		if cause, _, _ := maybe.RootCause(m).Get(); cause != joined {
+			t.Errorf("expected joined error, got %v", cause)
+		}
+	})
+
+	t.Run("returns None for Some and None", func(t *testing.T) {
+		for i, m := range []maybe.Maybe[int]{maybe.Just(1), maybe.Empty[int]()} {
+			if _, ok := maybe.RootCause(m).(maybe.None[error]); !ok {
+				t.Errorf("case %d: expected None", i)
+			}
+		}
+	})
+}
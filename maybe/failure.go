@@ -1,5 +1,11 @@
 package maybe
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
 // Failure represents a Maybe that contains an error.
 // It is one of the three concrete implementations of the Maybe interface.
 // Failure wraps an error and propagates it through the computation chain.
@@ -65,6 +71,35 @@ func (f Failure[T]) MapIfFailed(fn func(error) (T, error)) Maybe[T] {
 	})
 }
 
+// MapError applies fn to the error and returns a Failure with the error it returns.
+// If fn returns nil, the original Failure is returned, since a Failure needs an error.
+// If fn panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	failure := Failed[int](sql.ErrNoRows)
+//	result := failure.MapError(func(err error) error {
+//	    return fmt.Errorf("loading user: %w", err)
+//	}) // Failed[int]("loading user: sql: no rows in result set")
+func (f Failure[T]) MapError(fn func(error) error) Maybe[T] {
+	return Do(func() Maybe[T] {
+		if err := fn(f.e); err != nil {
+			return Failed[T](err)
+		}
+		return f
+	})
+}
+
+// MapSoft returns the original Failure without calling fn or onError.
+// An earlier hard failure is not downgraded to a warning.
+//
+// Example:
+//
+//	result := Failed[int](err).MapSoft(fn, onError) // Failed[int](err)
+func (f Failure[T]) MapSoft(fn func(T) (T, error), onError func(error)) Maybe[T] {
+	return f
+}
+
 // FlatMap ignores the given function and propagates the error.
 // Since Failure represents an error state, no transformation is applied.
 // The error is preserved, and the type is kept as Failure[T].
@@ -91,6 +126,28 @@ func (f Failure[T]) Filter(fn func(T) bool) Maybe[T] {
 	return f
 }
 
+// FilterErr ignores the given function and returns Failure.
+// Since Failure represents an error state, no filtering is applied.
+//
+// Example:
+//
+//	failure := Failed[int](errors.New("failed"))
+//	result := failure.FilterErr(func(x int) (bool, error) { return x > 0, nil }) // Failed[int](error)
+func (f Failure[T]) FilterErr(fn func(T) (bool, error)) Maybe[T] {
+	return f
+}
+
+// Assert ignores the predicate and returns Failure.
+// The original error is preserved.
+//
+// Example:
+//
+//	failure := Failed[int](errors.New("failed"))
+//	result := failure.Assert(func(x int) bool { return x > 0 }, "count must be positive") // Failed[int](error)
+func (f Failure[T]) Assert(pred func(T) bool, msg string) Maybe[T] {
+	return f
+}
+
 // Then ignores the given function and returns Failure.
 // Since Failure represents an error state, no function application is performed.
 // The error is preserved and wrapped in a new Failure.
@@ -103,6 +160,30 @@ func (f Failure[T]) Then(fn func(T)) Maybe[T] {
 	return f
 }
 
+// TapNone ignores the given function and returns Failure.
+//
+// Example:
+//
+//	failure := Failed[int](errors.New("failed"))
+//	result := failure.TapNone(func() { println("missing") }) // Failed[int](error), nothing printed
+func (f Failure[T]) TapNone(fn func()) Maybe[T] {
+	return f
+}
+
+// TapError calls the given function with the error and returns the original Failure.
+// If the function panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	failure := Failed[int](errors.New("failed"))
+//	result := failure.TapError(func(err error) { println(err.Error()) }) // prints "failed", returns Failed[int](error)
+func (f Failure[T]) TapError(fn func(error)) Maybe[T] {
+	return Do(func() Maybe[T] {
+		fn(f.e)
+		return f
+	})
+}
+
 // Get returns zero value with presence flag false and the wrapped error.
 // This method provides direct access to the error state.
 //
@@ -152,6 +233,35 @@ func (f Failure[T]) OrPanic() T {
 	panic(f.e)
 }
 
+// Expect panics with an error that prefixes the wrapped error with msg and wraps it,
+// so errors.Is on a recovered panic still finds the original error.
+//
+// Example:
+//
+//	value := Failed[int](err).Expect("load config") // panics with "load config: <err>"
+func (f Failure[T]) Expect(msg string) T {
+	panic(fmt.Errorf("%s: %w", msg, f.e))
+}
+
+// MustGet panics with the wrapped error, exactly as OrPanic does.
+//
+// Example:
+//
+//	value := Failed[int](err).MustGet() // panics with err
+func (f Failure[T]) MustGet() T {
+	return f.OrPanic()
+}
+
+// GetOrZero returns the zero value of T, discarding the error.
+//
+// Example:
+//
+//	value := Failed[int](err).GetOrZero() // returns 0
+func (f Failure[T]) GetOrZero() T {
+	var zero T
+	return zero
+}
+
 // OrError converts Failure to Go's standard (T, error) tuple.
 // Since Failure contains an error, it returns (zero, error) with the wrapped error.
 // This provides natural integration with Go's error handling patterns.
@@ -174,6 +284,15 @@ func (f Failure[T]) OrError() (T, error) {
 	return zero, f.e
 }
 
+// ToResult returns Err with the wrapped error; noneErr is ignored.
+//
+// Example:
+//
+//	r := Failed[int](err).ToResult(ErrNotFound) // Err[int](err)
+func (f Failure[T]) ToResult(noneErr error) Result[T] {
+	return Err[T](f.e)
+}
+
 // MatchThen applies the given functions based on the type of Maybe.
 // If Maybe is Some, the some function is called with the value inside Some.
 // If Maybe is None, the none function is called.
@@ -189,3 +308,80 @@ func (f Failure[T]) MatchThen(someFn func(T), noneFn func(), failureFn func(erro
 		return f
 	})
 }
+
+// Accept calls the visitor's VisitFailure method and returns the original Failure.
+// If the visitor panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	result := Failed[int](err).Accept(visitor) // VisitFailure is called with err
+func (f Failure[T]) Accept(v Visitor[T]) Maybe[T] {
+	return Do(func() Maybe[T] {
+		v.VisitFailure(f.e)
+		return f
+	})
+}
+
+// ErrIs reports whether the Failure's error chain matches target, using errors.Is.
+//
+// Example:
+//
+//	Failed[int](fmt.Errorf("load: %w", ErrNotFound)).ErrIs(ErrNotFound) // true
+func (f Failure[T]) ErrIs(target error) bool {
+	return errors.Is(f.e, target)
+}
+
+// IsSome always returns false for Failure.
+//
+// Example:
+//
+//	Failed[int](err).IsSome() // false
+func (f Failure[T]) IsSome() bool {
+	return false
+}
+
+// IsNone always returns false for Failure.
+//
+// Example:
+//
+//	Failed[int](err).IsNone() // false
+func (f Failure[T]) IsNone() bool {
+	return false
+}
+
+// IsFailed always returns true for Failure.
+//
+// Example:
+//
+//	Failed[int](err).IsFailed() // true
+func (f Failure[T]) IsFailed() bool {
+	return true
+}
+
+// Exists always returns false for Failure without calling pred.
+//
+// Example:
+//
+//	Failed[int](err).Exists(func(x int) bool { return x > 0 }) // false
+func (f Failure[T]) Exists(pred func(T) bool) bool {
+	return false
+}
+
+// String formats the Failure as Failure(error message).
+//
+// Example:
+//
+//	fmt.Println(ToMaybe(strconv.Atoi("x"))) // Failure(strconv.Atoi: parsing "x": invalid syntax)
+func (f Failure[T]) String() string {
+	return fmt.Sprintf("Failure(%v)", f.e)
+}
+
+// GoString formats the Failure as the Go expression that creates it, with the error
+// rendered by %#v, for %#v output.
+//
+// Example:
+//
+//	fmt.Printf("%#v", Failed[int](io.EOF)) // maybe.Failed[int](&errors.errorString{s:"EOF"})
+func (f Failure[T]) GoString() string {
+	return fmt.Sprintf("maybe.Failed[%s](%#v)", reflect.TypeFor[T](), f.e)
+}
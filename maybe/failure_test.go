@@ -3,6 +3,8 @@ package maybe_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"testing"
 
 	"github.com/lonelywolflee/lw-project-fp-go/maybe"
@@ -371,6 +373,20 @@ func TestFailure_Filter(t *testing.T) {
 	})
 }
 
+func TestFailure_FilterErr(t *testing.T) {
+	t.Run("propagates error without executing the predicate", func(t *testing.T) {
+		err := errors.New("original error")
+		executed := false
+		result := maybe.Failed[int](err).FilterErr(func(x int) (bool, error) {
+			executed = true
+			return true, nil
+		})
+		if _, _, gotErr := result.Get(); gotErr != err || executed {
+			t.Errorf("expected %v without predicate call, got %v (executed=%v)", err, gotErr, executed)
+		}
+	})
+}
+
 func TestFailure_Then(t *testing.T) {
 	t.Run("propagates error and ignores function", func(t *testing.T) {
 		err := errors.New("original error")
@@ -487,6 +503,36 @@ func TestFailure_Then(t *testing.T) {
 	})
 }
 
+func TestFailure_TapNone(t *testing.T) {
+	t.Run("returns Failure without calling function", func(t *testing.T) {
+		err := errors.New("failed")
+		called := false
+		result := maybe.Failed[int](err).TapNone(func() { called = true })
+		if _, _, gotErr := result.Get(); gotErr != err || called {
+			t.Errorf("expected %v without call, got %v (called=%v)", err, gotErr, called)
+		}
+	})
+}
+
+func TestFailure_TapError(t *testing.T) {
+	t.Run("calls function with error and returns Failure", func(t *testing.T) {
+		err := errors.New("failed")
+		var seen error
+		result := maybe.Failed[int](err).TapError(func(e error) { seen = e })
+		if _, _, gotErr := result.Get(); gotErr != err || seen != err {
+			t.Errorf("expected %v passed and returned, got %v and %v", err, seen, gotErr)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		err := errors.New("failed")
+		result := maybe.Failed[int](err).TapError(func(error) { panic("boom") })
+		if _, _, gotErr := result.Get(); gotErr == nil || gotErr == err {
+			t.Errorf("expected panic error, got %v", gotErr)
+		}
+	})
+}
+
 func TestFailure_OrElseGet(t *testing.T) {
 	t.Run("calls function and returns result", func(t *testing.T) {
 		err := errors.New("test error")
@@ -1013,6 +1059,49 @@ func TestFailure_MapIfFailed(t *testing.T) {
 	})
 }
 
+func TestFailure_MapError(t *testing.T) {
+	t.Run("wraps the error", func(t *testing.T) {
+		original := errors.New("no rows")
+		result := maybe.Failed[int](original).MapError(func(err error) error {
+			return fmt.Errorf("loading user: %w", err)
+		})
+
+		_, _, err := result.Get()
+		if !errors.Is(err, original) || err.Error() != "loading user: no rows" {
+			t.Errorf("expected wrapped error, got %v", err)
+		}
+	})
+
+	t.Run("keeps original error when function returns nil", func(t *testing.T) {
+		original := errors.New("no rows")
+		result := maybe.Failed[int](original).MapError(func(error) error { return nil })
+		if _, _, err := result.Get(); err != original {
+			t.Errorf("expected %v, got %v", original, err)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		original := errors.New("no rows")
+		result := maybe.Failed[int](original).MapError(func(error) error { panic("boom") })
+		if _, _, err := result.Get(); err == nil || err == original {
+			t.Errorf("expected panic error, got %v", err)
+		}
+	})
+}
+
+func TestFailure_MapSoft(t *testing.T) {
+	t.Run("returns original Failure without calling functions", func(t *testing.T) {
+		err := errors.New("earlier")
+		result := maybe.Failed[int](err).MapSoft(
+			func(int) (int, error) { t.Error("fn should not be called"); return 0, nil },
+			func(error) { t.Error("onError should not be called") },
+		)
+		if _, _, got := result.Get(); got != err {
+			t.Errorf("expected original error, got %v", got)
+		}
+	})
+}
+
 func TestFailure_OrPanic(t *testing.T) {
 	t.Run("panics with the wrapped error", func(t *testing.T) {
 		testErr := errors.New("test error")
@@ -1107,6 +1196,37 @@ func TestFailure_OrPanic(t *testing.T) {
 	})
 }
 
+func TestFailure_Expect(t *testing.T) {
+	t.Run("panics with message wrapping the error", func(t *testing.T) {
+		cause := errors.New("connection refused")
+		_, _, err := maybe.Do(func() maybe.Maybe[int] {
+			return maybe.Just(maybe.Failed[int](cause).Expect("connect to database"))
+		}).Get()
+		if !errors.Is(err, cause) || err.Error() != "connect to database: connection refused" {
+			t.Errorf("unexpected panic error %v", err)
+		}
+	})
+}
+
+func TestFailure_MustGetAndGetOrZero(t *testing.T) {
+	cause := errors.New("load failed")
+
+	t.Run("MustGet panics with the error", func(t *testing.T) {
+		_, _, err := maybe.Do(func() maybe.Maybe[int] {
+			return maybe.Just(maybe.Failed[int](cause).MustGet())
+		}).Get()
+		if err != cause {
+			t.Errorf("expected %v, got %v", cause, err)
+		}
+	})
+
+	t.Run("GetOrZero returns zero value", func(t *testing.T) {
+		if got := maybe.Failed[int](cause).GetOrZero(); got != 0 {
+			t.Errorf("expected 0, got %d", got)
+		}
+	})
+}
+
 func TestFailure_OrError(t *testing.T) {
 	t.Run("returns zero value with wrapped error", func(t *testing.T) {
 		testErr := errors.New("test error")
@@ -1234,3 +1354,95 @@ func TestFailure_OrError(t *testing.T) {
 		}
 	})
 }
+
+func TestFailure_ToResult(t *testing.T) {
+	t.Run("preserves original error", func(t *testing.T) {
+		cause := errors.New("db down")
+		if _, err := maybe.Failed[int](cause).ToResult(errors.New("unused")).Get(); err != cause {
+			t.Errorf("expected %v, got %v", cause, err)
+		}
+	})
+}
+
+func TestFailure_Accept(t *testing.T) {
+	t.Run("calls VisitFailure with the error", func(t *testing.T) {
+		err := errors.New("lookup failed")
+		visitor := &recordingVisitor[int]{}
+		result := maybe.Failed[int](err).Accept(visitor)
+
+		if visitor.called != "failure" || visitor.err != err {
+			t.Errorf("expected VisitFailure(err), got %s(%v)", visitor.called, visitor.err)
+		}
+		if _, _, gotErr := result.Get(); gotErr != err {
+			t.Errorf("expected original Failure to be returned, got %v", gotErr)
+		}
+	})
+
+	t.Run("converts visitor panic to Failure", func(t *testing.T) {
+		_, _, err := maybe.Failed[int](errors.New("original")).Accept(&recordingVisitor[int]{panics: true}).Get()
+
+		if err == nil || err.Error() == "original" {
+			t.Errorf("expected panic error, got %v", err)
+		}
+	})
+}
+
+func TestFailure_ErrIs(t *testing.T) {
+	target := errors.New("not found")
+
+	t.Run("matches wrapped target", func(t *testing.T) {
+		if !maybe.Failed[int](fmt.Errorf("load user: %w", target)).ErrIs(target) {
+			t.Error("expected wrapped target to match")
+		}
+	})
+
+	t.Run("does not match other errors", func(t *testing.T) {
+		if maybe.Failed[int](errors.New("timeout")).ErrIs(target) {
+			t.Error("expected unrelated error not to match")
+		}
+	})
+}
+
+func TestFailure_StatePredicates(t *testing.T) {
+	t.Run("reports Failure state only", func(t *testing.T) {
+		m := maybe.Failed[int](errors.New("boom"))
+		if m.IsSome() != false || m.IsNone() != false || m.IsFailed() != true {
+			t.Errorf("unexpected predicates: IsSome=%v IsNone=%v IsFailed=%v", m.IsSome(), m.IsNone(), m.IsFailed())
+		}
+	})
+}
+
+func TestFailure_Exists(t *testing.T) {
+	t.Run("returns false without calling predicate", func(t *testing.T) {
+		called := false
+		got := maybe.Failed[int](errors.New("boom")).Exists(func(int) bool { called = true; return true })
+		if got || called {
+			t.Errorf("expected false without call, got %v (called=%v)", got, called)
+		}
+	})
+}
+
+func TestFailure_Assert(t *testing.T) {
+	t.Run("returns original Failure without calling predicate", func(t *testing.T) {
+		err := errors.New("earlier")
+		result := maybe.Failed[int](err).Assert(func(int) bool { t.Error("predicate should not be called"); return false }, "unused")
+		if _, _, got := result.Get(); got != err {
+			t.Errorf("expected original error, got %v", got)
+		}
+	})
+}
+
+func TestFailure_String(t *testing.T) {
+	t.Run("formats error message for %v", func(t *testing.T) {
+		got := fmt.Sprint(maybe.ToMaybe(strconv.Atoi("x")))
+		if got != `Failure(strconv.Atoi: parsing "x": invalid syntax)` {
+			t.Errorf("unexpected String %s", got)
+		}
+	})
+
+	t.Run("formats Go expression for %#v", func(t *testing.T) {
+		if got := fmt.Sprintf("%#v", maybe.Failed[int](io.EOF)); got != `maybe.Failed[int](&errors.errorString{s:"EOF"})` {
+			t.Errorf("unexpected GoString %s", got)
+		}
+	})
+}
@@ -260,3 +260,159 @@ func FlatMap[T, R any](m Maybe[T], fn func(T) Maybe[R]) (output Maybe[R]) {
 	)
 	return
 }
+
+// Chain2 safely traverses two nested nullable accessors, returning None at the first nil.
+// The accessors take and return pointers so that generated getters (protobuf, ORM models)
+// can be passed directly as method expressions.
+//
+// Behavior:
+//   - If a or any accessor result is nil: returns None (remaining accessors not called)
+//   - If every step is non-nil: returns Just(final pointer)
+//   - If an accessor panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	city := Chain2(req, (*Request).GetUser, (*User).GetAddress)
+//	// Just(address) or Empty[*Address]() if the user or address is missing
+func Chain2[A, B, C any](a *A, fB func(*A) *B, fC func(*B) *C) Maybe[*C] {
+	return FlatMap(fromPtr(a), func(a *A) Maybe[*C] {
+		return FlatMap(fromPtr(fB(a)), func(b *B) Maybe[*C] {
+			return fromPtr(fC(b))
+		})
+	})
+}
+
+// Chain3 safely traverses three nested nullable accessors, returning None at the first nil.
+// It behaves like Chain2 with one more step.
+//
+// Example:
+//
+//	zip := Chain3(req, (*Request).GetUser, (*User).GetAddress, (*Address).GetZip)
+//	// Just(zip) or Empty[*Zip]() if any step is nil
+func Chain3[A, B, C, D any](a *A, fB func(*A) *B, fC func(*B) *C, fD func(*C) *D) Maybe[*D] {
+	return FlatMap(Chain2(a, fB, fC), func(c *C) Maybe[*D] {
+		return fromPtr(fD(c))
+	})
+}
+
+// fromPtr wraps a non-nil pointer in Some and a nil pointer in None.
+func fromPtr[T any](p *T) Maybe[*T] {
+	if p == nil {
+		return Empty[*T]()
+	}
+	return Just(p)
+}
+
+// CollectWhile calls next repeatedly and gathers the values it produces until the first
+// None or Failure. The values collected before the stop are always returned, so the
+// length of the slice reports how many elements succeeded.
+//
+// Behavior:
+//   - If next returns Some: the value is appended and next is called again
+//   - If next returns None: stops and returns the collected values with a nil error
+//   - If next returns Failure or panics: stops and returns the collected values with the error
+//
+// Example:
+//
+//	rows, err := CollectWhile(func() Maybe[Row] {
+//	    return decoder.Next() // None at end of input, Failure on a corrupt record
+//	})
+//	// rows holds every record read before the corruption; err says why it stopped
+func CollectWhile[T any](next func() Maybe[T]) ([]T, error) {
+	values := []T{}
+	for {
+		value, ok, err := Do(next).Get()
+		if err != nil {
+			return values, err
+		}
+		if !ok {
+			return values, nil
+		}
+		values = append(values, value)
+	}
+}
+
+// Modify copies the value inside m, lets fn mutate the copy in place, and wraps the result.
+// It is an escape hatch for large structs whose fields are easier to update through a
+// pointer than by returning a new value. The copy is shallow: slices, maps and pointers
+// inside T still share their underlying data with the original.
+//
+// Behavior:
+//   - If m is Some: calls fn with a pointer to a copy and returns Just(copy)
+//   - If m is None: returns None (fn not called)
+//   - If m is Failure: returns Failure with same error (fn not called)
+//   - If fn panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	updated := Modify(user, func(u *User) {
+//	    u.LastLogin = now
+//	    u.LoginCount++
+//	})
+func Modify[T any](m Maybe[T], fn func(*T)) Maybe[T] {
+	return Map(m, func(v T) T {
+		fn(&v)
+		return v
+	})
+}
+
+// Sequence turns a slice of Maybes into a Maybe of a slice, for batches of independent
+// lookups that are only useful if every one of them succeeded.
+//
+// Behavior:
+//   - If any element is Failure: returns the first Failure, even if an earlier element is None
+//   - Otherwise, if any element is None: returns None
+//   - If every element is Some (or ms is empty): returns Just(values) in the original order
+//
+// Example:
+//
+//	users := Sequence([]Maybe[User]{findUser(1), findUser(2), findUser(3)})
+//	// Just([u1 u2 u3]) only if all three were found
+func Sequence[T any](ms []Maybe[T]) Maybe[[]T] {
+	values := make([]T, 0, len(ms))
+	missing := false
+	for _, m := range ms {
+		v, ok, err := m.Get()
+		if err != nil {
+			return Failed[[]T](err)
+		}
+		if !ok {
+			missing = true
+			continue
+		}
+		values = append(values, v)
+	}
+	if missing {
+		return Empty[[]T]()
+	}
+	return Just(values)
+}
+
+// Traverse applies fn to each item in order and collects the results, stopping at the first
+// item that does not produce a value. It is the fallible form of mapping over a slice.
+//
+// Behavior:
+//   - If fn returns Some for every item (or items is empty): returns Just(results) in order
+//   - If fn returns None or Failure: returns it immediately; fn is not called on later items
+//   - If fn panics: returns Failure with the panic converted to an error, as FlatMap does
+//
+// Example:
+//
+//	ids := []string{"1", "2", "x"}
+//	parsed := Traverse(ids, func(s string) Maybe[int] {
+//	    return ToMaybe(strconv.Atoi(s))
+//	}) // Failure from parsing "x"
+func Traverse[T, R any](items []T, fn func(T) Maybe[R]) Maybe[[]R] {
+	results := make([]R, 0, len(items))
+	for _, item := range items {
+		v, ok, err := Do(func() Maybe[R] { return fn(item) }).Get()
+		if err != nil {
+			return Failed[[]R](err)
+		}
+		if !ok {
+			return Empty[[]R]()
+		}
+		results = append(results, v)
+	}
+	return Just(results)
+}
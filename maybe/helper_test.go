@@ -885,3 +885,288 @@ func TestMapIfFailed_Integration(t *testing.T) {
 		}
 	})
 }
+
+type chainZip struct {
+	Code string
+}
+
+type chainAddress struct {
+	Zip *chainZip
+}
+
+type chainUser struct {
+	Address *chainAddress
+}
+
+func (u *chainUser) GetAddress() *chainAddress { return u.Address }
+func (a *chainAddress) GetZip() *chainZip      { return a.Zip }
+
+type chainRequest struct {
+	User *chainUser
+}
+
+func (r *chainRequest) GetUser() *chainUser { return r.User }
+
+func TestChain2(t *testing.T) {
+	t.Run("returns Some when every step is non-nil", func(t *testing.T) {
+		addr := &chainAddress{}
+		req := &chainRequest{User: &chainUser{Address: addr}}
+
+		result := maybe.Chain2(req, (*chainRequest).GetUser, (*chainUser).GetAddress)
+		value, ok, err := result.Get()
+		if !ok || err != nil || value != addr {
+			t.Errorf("expected Just(addr), got %v, %v, %v", value, ok, err)
+		}
+	})
+
+	t.Run("returns None when the root is nil", func(t *testing.T) {
+		called := false
+		result := maybe.Chain2((*chainRequest)(nil), func(r *chainRequest) *chainUser {
+			called = true
+			return nil
+		}, (*chainUser).GetAddress)
+
+		if _, ok := result.(maybe.None[*chainAddress]); !ok {
+			t.Fatal("expected None for nil root")
+		}
+		if called {
+			t.Error("accessors should not be called for nil root")
+		}
+	})
+
+	t.Run("returns None at the first nil", func(t *testing.T) {
+		called := false
+		result := maybe.Chain2(&chainRequest{}, (*chainRequest).GetUser, func(u *chainUser) *chainAddress {
+			called = true
+			return nil
+		})
+
+		if _, ok := result.(maybe.None[*chainAddress]); !ok {
+			t.Fatal("expected None for nil intermediate")
+		}
+		if called {
+			t.Error("later accessors should not be called after a nil")
+		}
+	})
+
+	t.Run("returns None when the last step is nil", func(t *testing.T) {
+		req := &chainRequest{User: &chainUser{}}
+		if _, ok := maybe.Chain2(req, (*chainRequest).GetUser, (*chainUser).GetAddress).(maybe.None[*chainAddress]); !ok {
+			t.Fatal("expected None for nil last step")
+		}
+	})
+
+	t.Run("converts accessor panic to Failure", func(t *testing.T) {
+		result := maybe.Chain2(&chainRequest{}, func(r *chainRequest) *chainUser {
+			panic("accessor failed")
+		}, (*chainUser).GetAddress)
+
+		if _, ok := result.(maybe.Failure[*chainAddress]); !ok {
+			t.Fatal("expected Failure when an accessor panics")
+		}
+	})
+}
+
+func TestChain3(t *testing.T) {
+	t.Run("returns Some when every step is non-nil", func(t *testing.T) {
+		zip := &chainZip{Code: "12345"}
+		req := &chainRequest{User: &chainUser{Address: &chainAddress{Zip: zip}}}
+
+		result := maybe.Chain3(req, (*chainRequest).GetUser, (*chainUser).GetAddress, (*chainAddress).GetZip)
+		value, ok, _ := result.Get()
+		if !ok || value.Code != "12345" {
+			t.Errorf("expected Just(zip), got %v", value)
+		}
+	})
+
+	t.Run("returns None when any step is nil", func(t *testing.T) {
+		requests := []*chainRequest{
+			nil,
+			{},
+			{User: &chainUser{}},
+			{User: &chainUser{Address: &chainAddress{}}},
+		}
+		for i, req := range requests {
+			result := maybe.Chain3(req, (*chainRequest).GetUser, (*chainUser).GetAddress, (*chainAddress).GetZip)
+			if _, ok := result.(maybe.None[*chainZip]); !ok {
+				t.Errorf("request %d: expected None", i)
+			}
+		}
+	})
+
+	t.Run("converts accessor panic to Failure", func(t *testing.T) {
+		req := &chainRequest{User: &chainUser{Address: &chainAddress{}}}
+		result := maybe.Chain3(req, (*chainRequest).GetUser, (*chainUser).GetAddress, func(a *chainAddress) *chainZip {
+			panic("accessor failed")
+		})
+
+		if _, ok := result.(maybe.Failure[*chainZip]); !ok {
+			t.Fatal("expected Failure when an accessor panics")
+		}
+	})
+}
+
+func TestCollectWhile(t *testing.T) {
+	source := func(items ...maybe.Maybe[int]) func() maybe.Maybe[int] {
+		return func() maybe.Maybe[int] {
+			next := items[0]
+			items = items[1:]
+			return next
+		}
+	}
+
+	t.Run("collects until None", func(t *testing.T) {
+		values, err := maybe.CollectWhile(source(maybe.Just(1), maybe.Just(2), maybe.Empty[int]()))
+		if err != nil || len(values) != 2 || values[0] != 1 || values[1] != 2 {
+			t.Errorf("expected [1 2] and nil error, got %v, %v", values, err)
+		}
+	})
+
+	t.Run("keeps prior values on Failure", func(t *testing.T) {
+		corrupt := errors.New("corrupt record")
+		values, err := maybe.CollectWhile(source(maybe.Just(1), maybe.Failed[int](corrupt), maybe.Just(3)))
+		if !errors.Is(err, corrupt) || len(values) != 1 || values[0] != 1 {
+			t.Errorf("expected [1] and corrupt error, got %v, %v", values, err)
+		}
+	})
+
+	t.Run("returns empty slice when first result stops", func(t *testing.T) {
+		values, err := maybe.CollectWhile(source(maybe.Empty[int]()))
+		if err != nil || values == nil || len(values) != 0 {
+			t.Errorf("expected empty non-nil slice, got %v, %v", values, err)
+		}
+	})
+
+	t.Run("converts panic to error", func(t *testing.T) {
+		calls := 0
+		values, err := maybe.CollectWhile(func() maybe.Maybe[int] {
+			calls++
+			if calls == 3 {
+				panic("reader exploded")
+			}
+			return maybe.Just(calls)
+		})
+		if err == nil || len(values) != 2 {
+			t.Errorf("expected two values and panic error, got %v, %v", values, err)
+		}
+	})
+}
+
+func TestModify(t *testing.T) {
+	type account struct {
+		Name    string
+		Balance int
+	}
+
+	t.Run("mutates a copy of the value", func(t *testing.T) {
+		original := account{Name: "alice", Balance: 10}
+		m := maybe.Just(original)
+
+		result := maybe.Modify[account](m, func(a *account) { a.Balance += 5 })
+		value, ok, _ := result.Get()
+		if !ok || value.Balance != 15 || value.Name != "alice" {
+			t.Errorf("expected modified copy, got %+v", value)
+		}
+		if v, _, _ := m.Get(); v.Balance != 10 {
+			t.Errorf("expected original to be unchanged, got %+v", v)
+		}
+	})
+
+	t.Run("does not call fn for None or Failure", func(t *testing.T) {
+		fn := func(a *account) { t.Error("fn should not be called") }
+		err := errors.New("load failed")
+
+		if _, ok := maybe.Modify[account](maybe.Empty[account](), fn).(maybe.None[account]); !ok {
+			t.Error("expected None")
+		}
+		if _, _, got := maybe.Modify[account](maybe.Failed[account](err), fn).Get(); got != err {
+			t.Errorf("expected original error, got %v", got)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		result := maybe.Modify[account](maybe.Just(account{}), func(a *account) { panic("bad update") })
+		if _, ok := result.(maybe.Failure[account]); !ok {
+			t.Error("expected Failure when fn panics")
+		}
+	})
+}
+
+func TestSequence(t *testing.T) {
+	t.Run("collects values when every element is Some", func(t *testing.T) {
+		got := maybe.Sequence([]maybe.Maybe[int]{maybe.Just(1), maybe.Just(2), maybe.Just(3)}).OrPanic()
+		if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+			t.Errorf("expected [1 2 3], got %v", got)
+		}
+	})
+
+	t.Run("returns first Failure even after a None", func(t *testing.T) {
+		first, second := errors.New("first"), errors.New("second")
+		_, _, err := maybe.Sequence([]maybe.Maybe[int]{
+			maybe.Just(1), maybe.Empty[int](), maybe.Failed[int](first), maybe.Failed[int](second),
+		}).Get()
+		if err != first {
+			t.Errorf("expected %v, got %v", first, err)
+		}
+	})
+
+	t.Run("returns None when any element is None", func(t *testing.T) {
+		if m := maybe.Sequence([]maybe.Maybe[int]{maybe.Just(1), maybe.Empty[int]()}); !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+	})
+
+	t.Run("returns empty slice for empty input", func(t *testing.T) {
+		got, ok, _ := maybe.Sequence[int](nil).Get()
+		if !ok || got == nil || len(got) != 0 {
+			t.Errorf("expected Just([]), got %v %v", got, ok)
+		}
+	})
+}
+
+func TestTraverse(t *testing.T) {
+	parse := func(s string) maybe.Maybe[int] { return maybe.ToMaybe(strconv.Atoi(s)) }
+
+	t.Run("collects results in order", func(t *testing.T) {
+		got := maybe.Traverse([]string{"1", "2", "3"}, parse).OrPanic()
+		if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+			t.Errorf("expected [1 2 3], got %v", got)
+		}
+	})
+
+	t.Run("stops at first Failure", func(t *testing.T) {
+		calls := 0
+		result := maybe.Traverse([]string{"1", "x", "3"}, func(s string) maybe.Maybe[int] {
+			calls++
+			return parse(s)
+		})
+		if !result.IsFailed() || calls != 2 {
+			t.Errorf("expected Failure after 2 calls, got %v after %d", result, calls)
+		}
+	})
+
+	t.Run("stops at first None", func(t *testing.T) {
+		calls := 0
+		result := maybe.Traverse([]int{1, 2, 3}, func(i int) maybe.Maybe[int] {
+			calls++
+			return maybe.Just(i).Filter(func(v int) bool { return v != 1 })
+		})
+		if !result.IsNone() || calls != 1 {
+			t.Errorf("expected None after 1 call, got %v after %d", result, calls)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		result := maybe.Traverse([]int{1}, func(int) maybe.Maybe[int] { panic("boom") })
+		if !result.IsFailed() {
+			t.Errorf("expected Failure, got %v", result)
+		}
+	})
+
+	t.Run("returns empty slice for empty input", func(t *testing.T) {
+		got, ok, _ := maybe.Traverse(nil, parse).Get()
+		if !ok || got == nil || len(got) != 0 {
+			t.Errorf("expected Just([]), got %v %v", got, ok)
+		}
+	})
+}
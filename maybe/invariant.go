@@ -0,0 +1,9 @@
+//go:build !fpdev
+
+package maybe
+
+// invariantViolated turns a failed Assert into a Failure. Builds with the fpdev tag
+// panic instead, so violations surface immediately during development.
+func invariantViolated[T any](err *InvariantError) Maybe[T] {
+	return Failure[T]{e: err}
+}
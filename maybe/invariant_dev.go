@@ -0,0 +1,9 @@
+//go:build fpdev
+
+package maybe
+
+// invariantViolated panics in fpdev builds so that a violated Assert stops the program
+// at the point of the bug instead of flowing down the chain as a Failure.
+func invariantViolated[T any](err *InvariantError) Maybe[T] {
+	panic(err)
+}
@@ -0,0 +1,25 @@
+//go:build fpdev
+
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestSome_Assert_Violation(t *testing.T) {
+	t.Run("panics with InvariantError in dev builds", func(t *testing.T) {
+		defer func() {
+			err, _ := recover().(error)
+			var invariant *maybe.InvariantError
+			if !errors.As(err, &invariant) || invariant.Msg != "must be positive" {
+				t.Errorf("expected InvariantError panic, got %v", err)
+			}
+		}()
+
+		maybe.Just(-1).Assert(func(x int) bool { return x > 0 }, "must be positive")
+		t.Error("expected panic")
+	})
+}
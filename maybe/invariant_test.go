@@ -0,0 +1,24 @@
+//go:build !fpdev
+
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestSome_Assert_Violation(t *testing.T) {
+	t.Run("returns InvariantError Failure when invariant is violated", func(t *testing.T) {
+		_, _, err := maybe.Just(-1).Assert(func(x int) bool { return x > 0 }, "must be positive").Get()
+
+		var invariant *maybe.InvariantError
+		if !errors.As(err, &invariant) || invariant.Msg != "must be positive" {
+			t.Fatalf("expected InvariantError, got %v", err)
+		}
+		if err.Error() != "invariant violated: must be positive" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes the Some as its value, so a Maybe struct field serializes as the
+// plain value rather than as a wrapper object.
+//
+// Example:
+//
+//	json.Marshal(Just("Alice")) // "Alice"
+func (s Some[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.v)
+}
+
+// MarshalJSON encodes the None as null.
+//
+// Example:
+//
+//	json.Marshal(Empty[string]()) // null
+func (n None[T]) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// MarshalJSON returns the Failure's error, so a document containing a Failure cannot be
+// serialized by accident as if the value were merely absent.
+//
+// Example:
+//
+//	_, err := json.Marshal(Failed[string](err)) // err wraps the Failure's error
+func (f Failure[T]) MarshalJSON() ([]byte, error) {
+	return nil, fmt.Errorf("maybe: cannot marshal Failure: %w", f.e)
+}
+
+// Field is a JSON-friendly holder for a Maybe, for use as a struct field in API DTOs in place
+// of a pointer. A Maybe field alone can be marshaled but not unmarshaled, because
+// encoding/json cannot decode into an interface; Field gives the decoder a concrete type.
+//
+// A value decodes to Some, and null or a missing key decodes to None. With the omitzero
+// option, a None field is left out when encoding.
+//
+// Example:
+//
+//	type UpdateUser struct {
+//	    Name  maybe.Field[string] `json:"name,omitzero"`
+//	    Email maybe.Field[string] `json:"email,omitzero"`
+//	}
+//
+//	var req UpdateUser
+//	json.Unmarshal([]byte(`{"name":"Bob"}`), &req)
+//	req.Name.Maybe()  // Just("Bob")
+//	req.Email.Maybe() // Empty[string]()
+type Field[T any] struct {
+	m Maybe[T]
+}
+
+// FieldOf wraps m in a Field.
+//
+// Example:
+//
+//	resp := UserDTO{Nickname: maybe.FieldOf(user.Nickname)}
+func FieldOf[T any](m Maybe[T]) Field[T] {
+	return Field[T]{m: m}
+}
+
+// Maybe returns the wrapped Maybe. The zero Field, such as one whose key was missing from
+// the decoded document, returns None.
+func (f Field[T]) Maybe() Maybe[T] {
+	if f.m == nil {
+		return Empty[T]()
+	}
+	return f.m
+}
+
+// IsZero reports whether the Field is None, which lets the omitzero option drop it.
+// A Failure is not zero, so encoding it still reports the error.
+func (f Field[T]) IsZero() bool {
+	return f.Maybe().IsNone()
+}
+
+// MarshalJSON encodes the wrapped Maybe: the value for Some, null for None,
+// and an error for Failure.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Maybe())
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+// A value that does not decode into T returns the decoding error.
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		f.m = Empty[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	f.m = Just(v)
+	return nil
+}
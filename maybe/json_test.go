@@ -0,0 +1,89 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type updateUser struct {
+	Name  maybe.Field[string] `json:"name,omitzero"`
+	Email maybe.Field[string] `json:"email"`
+	Age   maybe.Field[int]    `json:"age,omitzero"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("encodes Maybe fields as value or null", func(t *testing.T) {
+		dto := struct {
+			Name     maybe.Maybe[string] `json:"name"`
+			Nickname maybe.Maybe[string] `json:"nickname"`
+		}{Name: maybe.Just("Alice"), Nickname: maybe.Empty[string]()}
+
+		data, err := json.Marshal(dto)
+		if err != nil || string(data) != `{"name":"Alice","nickname":null}` {
+			t.Errorf("unexpected encoding %s %v", data, err)
+		}
+	})
+
+	t.Run("refuses to encode Failure", func(t *testing.T) {
+		errLoad := errors.New("load failed")
+		_, err := json.Marshal(maybe.Failed[string](errLoad))
+		if !errors.Is(err, errLoad) {
+			t.Errorf("expected Failure's error, got %v", err)
+		}
+	})
+}
+
+func TestField(t *testing.T) {
+	t.Run("decodes value as Some and null or missing as None", func(t *testing.T) {
+		var req updateUser
+		if err := json.Unmarshal([]byte(`{"name":"Bob","email":null}`), &req); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if v, ok, _ := req.Name.Maybe().Get(); !ok || v != "Bob" {
+			t.Errorf("expected Just(Bob), got %v", req.Name.Maybe())
+		}
+		if !req.Email.Maybe().IsNone() || !req.Age.Maybe().IsNone() {
+			t.Errorf("expected None for null and missing, got %v %v", req.Email.Maybe(), req.Age.Maybe())
+		}
+	})
+
+	t.Run("returns decoding error for wrong type", func(t *testing.T) {
+		var req updateUser
+		if err := json.Unmarshal([]byte(`{"age":"old"}`), &req); err == nil {
+			t.Error("expected decoding error")
+		}
+	})
+
+	t.Run("encodes Some and None, omitting None with omitzero", func(t *testing.T) {
+		req := updateUser{Name: maybe.FieldOf[string](maybe.Just("Bob"))}
+		data, err := json.Marshal(req)
+		if err != nil || string(data) != `{"name":"Bob","email":null}` {
+			t.Errorf("unexpected encoding %s %v", data, err)
+		}
+	})
+
+	t.Run("round-trips through JSON", func(t *testing.T) {
+		in := updateUser{Name: maybe.FieldOf[string](maybe.Just("Bob")), Age: maybe.FieldOf[int](maybe.Just(30))}
+		data, _ := json.Marshal(in)
+		var out updateUser
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if !maybe.Equal(in.Name.Maybe(), out.Name.Maybe()) || !maybe.Equal(in.Age.Maybe(), out.Age.Maybe()) {
+			t.Errorf("round trip mismatch: %+v", out)
+		}
+	})
+
+	t.Run("keeps Failure visible when encoding", func(t *testing.T) {
+		f := maybe.FieldOf[string](maybe.Failed[string](errors.New("x")))
+		if f.IsZero() {
+			t.Error("expected Failure not to be zero")
+		}
+		if _, err := json.Marshal(updateUser{Name: f}); err == nil {
+			t.Error("expected encoding error")
+		}
+	})
+}
@@ -22,6 +22,18 @@ package maybe
 //
 //	import "strconv"
 //	result := Map(Just(42), strconv.Itoa)  // int → string: Just("42")
+//
+// Implementing Maybe: other packages may add their own states, such as a cached or pending
+// value, by implementing this interface. An implementation must present itself as exactly one
+// of the three states, chosen by what Get returns (a non-nil error means Failure, otherwise
+// ok means Some and !ok means None), and every other method must behave as the built-in type
+// for that state does. The helper functions in this package rely only on that contract.
+// Run the checks in package maybetest against an implementation to verify it.
+//
+// The method set is not frozen: new operations may be added to this interface in later
+// releases. An outside implementation must therefore embed the built-in type for the state it
+// presents, as in struct{ maybe.Some[T] }, and override only the methods it changes, so that
+// methods added later are inherited and keep it compiling and behaving as that state.
 type Maybe[T any] interface {
 	// Map transforms the value inside Maybe using the provided function.
 	// The function must return the same type T.
@@ -134,6 +146,37 @@ type Maybe[T any] interface {
 	//	}) // Try cache if fetch fails
 	MapIfFailed(fn func(error) (T, error)) Maybe[T]
 
+	// MapError rewrites the error inside a Failure without recovering from it, typically
+	// to add context. Use MapIfFailed when the failure may turn back into a value.
+	// If Maybe is Some or None, the function is not called and the state is preserved.
+	// If the function returns nil, the original error is kept.
+	// If the function panics, it's caught and converted to a Failure.
+	//
+	// Example:
+	//
+	//	result := loadUser(id).MapError(func(err error) error {
+	//	    return fmt.Errorf("loading user %s: %w", id, err)
+	//	}) // Failed[User](wrapped error) if loadUser failed
+	MapError(fn func(error) error) Maybe[T]
+
+	// MapSoft is a best-effort Map: when fn fails, the error is reported to onError as a warning
+	// and the original value keeps flowing instead of the chain turning into a Failure.
+	// Use it for optional enrichment steps, such as a geo lookup, that should not fail the pipeline.
+	//
+	// Behavior:
+	//   - If Maybe is Some and fn succeeds: returns Just(new value)
+	//   - If Maybe is Some and fn returns an error or panics: calls onError and returns the original Some
+	//   - If Maybe is None or Failure: returns it unchanged (neither function called)
+	//   - If onError panics: returns Failure with the panic converted to an error
+	//
+	// Example:
+	//
+	//	var warnings []error
+	//	order := loadOrder(id).
+	//	    MapSoft(attachGeo, func(err error) { warnings = append(warnings, err) })
+	//	// Just(order with geo), or Just(order) plus a warning when the lookup fails
+	MapSoft(fn func(T) (T, error), onError func(error)) Maybe[T]
+
 	// FlatMap is similar to Map but expects the function to return a Maybe[T].
 	// This prevents nested Maybe structures and is useful for chaining operations that might fail.
 	// The function must return Maybe[T] (same type).
@@ -167,6 +210,37 @@ type Maybe[T any] interface {
 	//	result := Just(3).Filter(func(x int) bool { return x > 5 })  // Empty[int]()
 	Filter(fn func(T) bool) Maybe[T]
 
+	// FilterErr is Filter for predicates that can fail, such as checks that do I/O.
+	// If the predicate returns (true, nil), the Maybe is unchanged.
+	// If the predicate returns (false, nil), the Maybe becomes None.
+	// If the predicate returns an error, the Maybe becomes Failure with that error.
+	// If Maybe is None or Failure, the predicate is not applied and the state is preserved.
+	// If the function panics, it's caught and converted to a Failure.
+	//
+	// Example:
+	//
+	//	result := Just(userID).FilterErr(func(id string) (bool, error) {
+	//	    return store.IsActive(ctx, id)
+	//	}) // Just(userID), Empty[string]() or Failed[string](lookup error)
+	FilterErr(fn func(T) (bool, error)) Maybe[T]
+
+	// Assert checks an invariant of the value: a condition that should be impossible to
+	// violate if the program is correct. Use Filter for expected, data-driven rejections;
+	// Assert marks a bug, so its Failure carries a dedicated *InvariantError.
+	//
+	// Behavior:
+	//   - If Maybe is Some and pred returns true: returns the original Some
+	//   - If Maybe is Some and pred returns false: returns Failure(*InvariantError{Msg: msg}),
+	//     or panics with that error in builds using the fpdev build tag
+	//   - If Maybe is None or Failure: returns it unchanged (pred not called)
+	//   - If pred panics: returns Failure with the panic converted to an error
+	//
+	// Example:
+	//
+	//	total := computeTotal(order).
+	//	    Assert(func(c Cents) bool { return c >= 0 }, "order total must not be negative")
+	Assert(pred func(T) bool, msg string) Maybe[T]
+
 	// Then applies a side-effect function to the value inside Maybe and returns the same Maybe.
 	// This is useful for performing actions like logging or debugging without changing the value.
 	// If Maybe is None or Failure, the function is not applied and the state is preserved.
@@ -178,6 +252,25 @@ type Maybe[T any] interface {
 	//	result := Empty[int]().Then(func(x int) { fmt.Println(x) }) // Empty[int](), nothing printed
 	Then(fn func(T)) Maybe[T]
 
+	// TapNone calls fn if the Maybe is None and returns the same Maybe. It is Then for the
+	// empty branch, for logging or counting misses mid-chain without a full MatchThen.
+	// If Maybe is Some or Failure, the function is not called and the state is preserved.
+	// If the function panics, it's caught and converted to a Failure.
+	//
+	// Example:
+	//
+	//	user := findUser(id).TapNone(func() { log.Printf("user %s not found", id) })
+	TapNone(fn func()) Maybe[T]
+
+	// TapError calls fn with the error if the Maybe is Failure and returns the same Maybe.
+	// If Maybe is Some or None, the function is not called and the state is preserved.
+	// If the function panics, it's caught and converted to a Failure.
+	//
+	// Example:
+	//
+	//	user := loadUser(id).TapError(func(err error) { log.Printf("loading user %s: %v", id, err) })
+	TapError(fn func(error)) Maybe[T]
+
 	// Get returns the value, presence flag, and error from Maybe.
 	// The boolean indicates whether a value is present (true for Some, false for None/Failure).
 	// This provides a Go-idiomatic way to distinguish between empty and error states.
@@ -267,6 +360,37 @@ type Maybe[T any] interface {
 	//	user := parseUser(data).OrPanic()  // test fails immediately if parsing fails
 	OrPanic() T
 
+	// Expect is OrPanic with a message describing what was expected, for panics that explain
+	// themselves in test output and startup logs. The panic value is an error, so Do and Try
+	// convert it back into a Failure that still matches the original error with errors.Is.
+	//
+	// Behavior:
+	//   - Some: returns the wrapped value
+	//   - None: panics with an error "<msg>: empty"
+	//   - Failure: panics with an error "<msg>: <wrapped error>" that wraps the original error
+	//
+	// Example:
+	//
+	//	var db = connectDatabase().Expect("connect to primary database")
+	//	// panics with "connect to primary database: dial tcp: connection refused"
+	Expect(msg string) T
+
+	// MustGet returns the value of a Some and panics otherwise, exactly as OrPanic does.
+	// It exists for readers who expect the Get-family name in quick scripts and tests.
+	//
+	// Example:
+	//
+	//	port := ToMaybe(strconv.Atoi(os.Getenv("PORT"))).MustGet()
+	MustGet() T
+
+	// GetOrZero returns the value of a Some, or the zero value of T for None and Failure,
+	// discarding any error. Use it only where the difference does not matter.
+	//
+	// Example:
+	//
+	//	count := lookupCount(key).GetOrZero() // 0 if missing or failed
+	GetOrZero() T
+
 	// OrError converts Maybe to Go's standard (T, error) tuple.
 	// This method provides seamless interoperability with Go's idiomatic error handling,
 	// allowing Maybe-based code to integrate naturally with standard Go functions and libraries.
@@ -312,6 +436,18 @@ type Maybe[T any] interface {
 	//	}).OrError()
 	OrError() (T, error)
 
+	// ToResult converts the Maybe into a Result, treating absence as the error noneErr.
+	//
+	// Behavior:
+	//   - Some: returns Ok(value)
+	//   - None: returns Err(noneErr), or an "empty" error (as OrError uses) if noneErr is nil
+	//   - Failure: returns Err with the original error, ignoring noneErr
+	//
+	// Example:
+	//
+	//	user, err := findUser(id).ToResult(ErrNotFound).Get()
+	ToResult(noneErr error) Result[T]
+
 	// MatchThen performs pattern matching on the Maybe type and executes the appropriate function for side effects.
 	// This provides a type-safe way to handle all three Maybe states (Some, None, Failure) with custom behavior.
 	// The function returns the original Maybe unchanged, making it suitable for chaining.
@@ -342,4 +478,68 @@ type Maybe[T any] interface {
 	//	    func(err error) { fmt.Printf("Error: %v\n", err) },
 	//	) // prints "Error: <error message>", returns Failed[int](err)
 	MatchThen(someFn func(T), noneFn func(), failureFn func(error)) Maybe[T]
+
+	// ErrIs reports whether the Maybe is a Failure whose error chain matches target,
+	// as errors.Is does. It lets call sites branch on a failure cause without unwrapping first.
+	//
+	// Example:
+	//
+	//	if result.ErrIs(sql.ErrNoRows) {
+	//	    return http.StatusNotFound
+	//	}
+	ErrIs(target error) bool
+
+	// IsSome reports whether the Maybe holds a value. IsNone and IsFailed report the other two
+	// states; exactly one of the three is true. They let callers branch on state without
+	// type-asserting against the concrete Some, None and Failure types.
+	//
+	// Example:
+	//
+	//	if user.IsNone() {
+	//	    return http.StatusNotFound
+	//	}
+	IsSome() bool
+
+	// IsNone reports whether the Maybe is empty. See IsSome.
+	IsNone() bool
+
+	// IsFailed reports whether the Maybe holds an error. See IsSome.
+	IsFailed() bool
+
+	// Exists reports whether the Maybe is Some and its value satisfies pred. It is a
+	// shorthand for Filter(pred).IsSome() that does not build an intermediate Maybe.
+	// None and Failure always report false without calling pred.
+	// Since Exists returns a plain bool, a panic in pred is not recovered.
+	// For comparable values, the Contains helper compares against a value directly.
+	//
+	// Example:
+	//
+	//	if user.Exists(func(u User) bool { return u.IsAdmin }) {
+	//	    showAdminPanel()
+	//	}
+	Exists(pred func(T) bool) bool
+
+	// Accept dispatches to the Visitor method matching the Maybe's state and returns the
+	// original Maybe unchanged. Because Visitor is an interface, a visitor type that is
+	// missing one of the three methods fails to compile, which makes Accept the
+	// compiler-checked alternative to MatchThen.
+	// If the visitor method panics, the panic is caught and converted to a Failure.
+	//
+	// Example:
+	//
+	//	type logVisitor struct{ log *slog.Logger }
+	//
+	//	func (v logVisitor) VisitSome(u User)       { v.log.Info("found", "id", u.ID) }
+	//	func (v logVisitor) VisitNone()             { v.log.Info("not found") }
+	//	func (v logVisitor) VisitFailure(err error) { v.log.Error("lookup failed", "err", err) }
+	//
+	//	result := findUser(id).Accept(logVisitor{log: logger})
+	Accept(v Visitor[T]) Maybe[T]
+}
+
+// Visitor handles each of the three Maybe states. It is used with Maybe.Accept.
+type Visitor[T any] interface {
+	VisitSome(v T)
+	VisitNone()
+	VisitFailure(err error)
 }
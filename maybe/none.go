@@ -1,6 +1,10 @@
 package maybe
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 // None represents a Maybe that contains no value.
 // It is one of the three concrete implementations of the Maybe interface.
@@ -60,6 +64,28 @@ func (n None[T]) MapIfFailed(fn func(error) (T, error)) Maybe[T] {
 	return n
 }
 
+// MapError returns the original None unchanged since there is no error to rewrite.
+// Use MapIfEmpty to turn None into a Failure.
+//
+// Example:
+//
+//	none := Empty[int]()
+//	result := none.MapError(func(err error) error {
+//	    return fmt.Errorf("context: %w", err) // This function is never called
+//	}) // Empty[int]()
+func (n None[T]) MapError(fn func(error) error) Maybe[T] {
+	return n
+}
+
+// MapSoft returns None without calling fn or onError.
+//
+// Example:
+//
+//	result := Empty[int]().MapSoft(fn, onError) // Empty[int]()
+func (n None[T]) MapSoft(fn func(T) (T, error), onError func(error)) Maybe[T] {
+	return n
+}
+
 // FlatMap ignores the given function and returns None.
 // Since None has no value, there's nothing to transform.
 // The type is preserved, returning None[T].
@@ -85,6 +111,28 @@ func (n None[T]) Filter(fn func(T) bool) Maybe[T] {
 	return n
 }
 
+// FilterErr ignores the given function and returns None.
+// Since None has no value, there's nothing to filter.
+//
+// Example:
+//
+//	none := Empty[int]()
+//	result := none.FilterErr(func(x int) (bool, error) { return x > 0, nil }) // Empty[int]()
+func (n None[T]) FilterErr(fn func(T) (bool, error)) Maybe[T] {
+	return n
+}
+
+// Assert ignores the predicate and returns None.
+// Since None has no value, there's no invariant to check.
+//
+// Example:
+//
+//	none := Empty[int]()
+//	result := none.Assert(func(x int) bool { return x > 0 }, "count must be positive") // Empty[int]()
+func (n None[T]) Assert(pred func(T) bool, msg string) Maybe[T] {
+	return n
+}
+
 // Then ignores the given function and returns None.
 // Since None has no value, there's nothing to apply the function to.
 //
@@ -96,6 +144,31 @@ func (n None[T]) Then(fn func(T)) Maybe[T] {
 	return n
 }
 
+// TapNone calls the given function and returns the original None.
+// If the function panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	none := Empty[int]()
+//	result := none.TapNone(func() { println("missing") }) // prints "missing", returns Empty[int]()
+func (n None[T]) TapNone(fn func()) Maybe[T] {
+	return Do(func() Maybe[T] {
+		fn()
+		return n
+	})
+}
+
+// TapError ignores the given function and returns None.
+// Since None holds no error, there's nothing to pass to the function.
+//
+// Example:
+//
+//	none := Empty[int]()
+//	result := none.TapError(func(err error) { println(err) }) // Empty[int](), nothing printed
+func (n None[T]) TapError(fn func(error)) Maybe[T] {
+	return n
+}
+
 // Get returns zero value with presence flag false and no error, indicating the absence of a value.
 //
 // Example:
@@ -141,6 +214,34 @@ func (n None[T]) OrPanic() T {
 	panic("empty")
 }
 
+// Expect panics with an error reading "<msg>: empty" since None has no value to return.
+//
+// Example:
+//
+//	value := Empty[int]().Expect("port must be set") // panics with "port must be set: empty"
+func (n None[T]) Expect(msg string) T {
+	panic(errors.New(msg + ": empty"))
+}
+
+// MustGet panics with "empty" since None has no value to return, exactly as OrPanic does.
+//
+// Example:
+//
+//	value := Empty[int]().MustGet() // panics with "empty"
+func (n None[T]) MustGet() T {
+	return n.OrPanic()
+}
+
+// GetOrZero returns the zero value of T.
+//
+// Example:
+//
+//	value := Empty[int]().GetOrZero() // returns 0
+func (n None[T]) GetOrZero() T {
+	var zero T
+	return zero
+}
+
 // OrError converts None to Go's standard (T, error) tuple.
 // Since None represents absence without a specific error, it returns (zero, error("empty")).
 // This allows None to be treated as an error condition in standard Go error handling.
@@ -159,6 +260,18 @@ func (n None[T]) OrError() (T, error) {
 	return zero, errors.New("empty")
 }
 
+// ToResult returns Err(noneErr), or the same "empty" error as OrError when noneErr is nil.
+//
+// Example:
+//
+//	r := Empty[int]().ToResult(ErrNotFound) // Err[int](ErrNotFound)
+func (n None[T]) ToResult(noneErr error) Result[T] {
+	if noneErr == nil {
+		_, noneErr = n.OrError()
+	}
+	return Err[T](noneErr)
+}
+
 // MatchThen applies the given functions based on the type of Maybe.
 // If Maybe is Some, the some function is called with the value inside Some.
 // If Maybe is None, the none function is called.
@@ -175,3 +288,79 @@ func (n None[T]) MatchThen(someFn func(T), noneFn func(), failureFn func(error))
 		return n
 	})
 }
+
+// Accept calls the visitor's VisitNone method and returns the original None.
+// If the visitor panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	result := Empty[int]().Accept(visitor) // VisitNone is called
+func (n None[T]) Accept(v Visitor[T]) Maybe[T] {
+	return Do(func() Maybe[T] {
+		v.VisitNone()
+		return n
+	})
+}
+
+// ErrIs always returns false because None holds no error.
+//
+// Example:
+//
+//	Empty[int]().ErrIs(ErrNotFound) // false
+func (n None[T]) ErrIs(target error) bool {
+	return false
+}
+
+// IsSome always returns false for None.
+//
+// Example:
+//
+//	Empty[int]().IsSome() // false
+func (n None[T]) IsSome() bool {
+	return false
+}
+
+// IsNone always returns true for None.
+//
+// Example:
+//
+//	Empty[int]().IsNone() // true
+func (n None[T]) IsNone() bool {
+	return true
+}
+
+// IsFailed always returns false for None.
+//
+// Example:
+//
+//	Empty[int]().IsFailed() // false
+func (n None[T]) IsFailed() bool {
+	return false
+}
+
+// Exists always returns false for None without calling pred.
+//
+// Example:
+//
+//	Empty[int]().Exists(func(x int) bool { return x > 0 }) // false
+func (n None[T]) Exists(pred func(T) bool) bool {
+	return false
+}
+
+// String formats the None as None.
+//
+// Example:
+//
+//	fmt.Println(Empty[int]()) // None
+func (n None[T]) String() string {
+	return "None"
+}
+
+// GoString formats the None as the Go expression that creates it, for %#v output.
+//
+// Example:
+//
+//	fmt.Printf("%#v", Empty[int]()) // maybe.Empty[int]()
+func (n None[T]) GoString() string {
+	return fmt.Sprintf("maybe.Empty[%s]()", reflect.TypeFor[T]())
+}
@@ -2,6 +2,7 @@ package maybe_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/lonelywolflee/lw-project-fp-go/maybe"
@@ -227,6 +228,19 @@ func TestNone_Filter(t *testing.T) {
 	})
 }
 
+func TestNone_FilterErr(t *testing.T) {
+	t.Run("returns None without executing the predicate", func(t *testing.T) {
+		executed := false
+		result := maybe.Empty[int]().FilterErr(func(x int) (bool, error) {
+			executed = true
+			return true, nil
+		})
+		if _, ok := result.(maybe.None[int]); !ok || executed {
+			t.Errorf("expected None without predicate call, got %v (executed=%v)", result, executed)
+		}
+	})
+}
+
 func TestNone_Then(t *testing.T) {
 	t.Run("returns None and ignores function", func(t *testing.T) {
 		none := maybe.Empty[int]()
@@ -303,6 +317,33 @@ func TestNone_Then(t *testing.T) {
 	})
 }
 
+func TestNone_TapNone(t *testing.T) {
+	t.Run("calls function and returns None", func(t *testing.T) {
+		called := false
+		result := maybe.Empty[int]().TapNone(func() { called = true })
+		if _, ok := result.(maybe.None[int]); !ok || !called {
+			t.Errorf("expected None after call, got %v (called=%v)", result, called)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		result := maybe.Empty[int]().TapNone(func() { panic("boom") })
+		if !result.IsFailed() {
+			t.Errorf("expected Failure, got %v", result)
+		}
+	})
+}
+
+func TestNone_TapError(t *testing.T) {
+	t.Run("returns None without calling function", func(t *testing.T) {
+		called := false
+		result := maybe.Empty[int]().TapError(func(error) { called = true })
+		if _, ok := result.(maybe.None[int]); !ok || called {
+			t.Errorf("expected None without call, got %v (called=%v)", result, called)
+		}
+	})
+}
+
 func TestNone_OrElseGet(t *testing.T) {
 	t.Run("calls function and returns result", func(t *testing.T) {
 		none := maybe.Empty[int]()
@@ -725,6 +766,31 @@ func TestNone_MapIfFailed(t *testing.T) {
 	})
 }
 
+func TestNone_MapError(t *testing.T) {
+	t.Run("returns None without calling function", func(t *testing.T) {
+		called := false
+		result := maybe.Empty[int]().MapError(func(err error) error {
+			called = true
+			return err
+		})
+		if _, ok := result.(maybe.None[int]); !ok || called {
+			t.Errorf("expected None without call, got %v (called=%v)", result, called)
+		}
+	})
+}
+
+func TestNone_MapSoft(t *testing.T) {
+	t.Run("returns None without calling functions", func(t *testing.T) {
+		result := maybe.Empty[int]().MapSoft(
+			func(int) (int, error) { t.Error("fn should not be called"); return 0, nil },
+			func(error) { t.Error("onError should not be called") },
+		)
+		if !result.IsNone() {
+			t.Errorf("expected None, got %v", result)
+		}
+	})
+}
+
 func TestNone_OrPanic(t *testing.T) {
 	t.Run("panics with empty message", func(t *testing.T) {
 		none := maybe.Empty[int]()
@@ -782,6 +848,34 @@ func TestNone_OrPanic(t *testing.T) {
 	})
 }
 
+func TestNone_Expect(t *testing.T) {
+	t.Run("panics with message", func(t *testing.T) {
+		_, _, err := maybe.Do(func() maybe.Maybe[int] {
+			return maybe.Just(maybe.Empty[int]().Expect("port must be set"))
+		}).Get()
+		if err == nil || err.Error() != "port must be set: empty" {
+			t.Errorf("unexpected panic error %v", err)
+		}
+	})
+}
+
+func TestNone_MustGetAndGetOrZero(t *testing.T) {
+	t.Run("MustGet panics with empty", func(t *testing.T) {
+		_, _, err := maybe.Do(func() maybe.Maybe[int] {
+			return maybe.Just(maybe.Empty[int]().MustGet())
+		}).Get()
+		if err == nil || err.Error() != "empty" {
+			t.Errorf("unexpected panic error %v", err)
+		}
+	})
+
+	t.Run("GetOrZero returns zero value", func(t *testing.T) {
+		if got := maybe.Empty[string]().GetOrZero(); got != "" {
+			t.Errorf("expected empty string, got %q", got)
+		}
+	})
+}
+
 func TestNone_OrError(t *testing.T) {
 	t.Run("returns zero value with empty error", func(t *testing.T) {
 		none := maybe.Empty[int]()
@@ -864,3 +958,91 @@ func TestNone_OrError(t *testing.T) {
 		}
 	})
 }
+
+func TestNone_ToResult(t *testing.T) {
+	t.Run("returns Err with noneErr", func(t *testing.T) {
+		errNotFound := errors.New("not found")
+		if _, err := maybe.Empty[int]().ToResult(errNotFound).Get(); err != errNotFound {
+			t.Errorf("expected %v, got %v", errNotFound, err)
+		}
+	})
+
+	t.Run("uses empty error when noneErr is nil", func(t *testing.T) {
+		r := maybe.Empty[int]().ToResult(nil)
+		if _, err := r.Get(); r.IsOk() || err == nil || err.Error() != "empty" {
+			t.Errorf("expected empty error, got %v", err)
+		}
+	})
+}
+
+func TestNone_Accept(t *testing.T) {
+	t.Run("calls VisitNone", func(t *testing.T) {
+		visitor := &recordingVisitor[int]{}
+		result := maybe.Empty[int]().Accept(visitor)
+
+		if visitor.called != "none" {
+			t.Errorf("expected VisitNone, got %s", visitor.called)
+		}
+		if _, ok := result.(maybe.None[int]); !ok {
+			t.Error("expected original None to be returned")
+		}
+	})
+
+	t.Run("converts visitor panic to Failure", func(t *testing.T) {
+		result := maybe.Empty[int]().Accept(&recordingVisitor[int]{panics: true})
+
+		if _, ok := result.(maybe.Failure[int]); !ok {
+			t.Error("expected Failure when visitor panics")
+		}
+	})
+}
+
+func TestNone_ErrIs(t *testing.T) {
+	t.Run("always returns false", func(t *testing.T) {
+		if maybe.Empty[int]().ErrIs(errors.New("any")) {
+			t.Error("expected false for None")
+		}
+	})
+}
+
+func TestNone_StatePredicates(t *testing.T) {
+	t.Run("reports None state only", func(t *testing.T) {
+		m := maybe.Empty[int]()
+		if m.IsSome() != false || m.IsNone() != true || m.IsFailed() != false {
+			t.Errorf("unexpected predicates: IsSome=%v IsNone=%v IsFailed=%v", m.IsSome(), m.IsNone(), m.IsFailed())
+		}
+	})
+}
+
+func TestNone_Exists(t *testing.T) {
+	t.Run("returns false without calling predicate", func(t *testing.T) {
+		called := false
+		got := maybe.Empty[int]().Exists(func(int) bool { called = true; return true })
+		if got || called {
+			t.Errorf("expected false without call, got %v (called=%v)", got, called)
+		}
+	})
+}
+
+func TestNone_Assert(t *testing.T) {
+	t.Run("returns None without calling predicate", func(t *testing.T) {
+		result := maybe.Empty[int]().Assert(func(int) bool { t.Error("predicate should not be called"); return false }, "unused")
+		if _, ok := result.(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+	})
+}
+
+func TestNone_String(t *testing.T) {
+	t.Run("formats as None for %v", func(t *testing.T) {
+		if got := fmt.Sprint(maybe.Empty[int]()); got != "None" {
+			t.Errorf("expected None, got %s", got)
+		}
+	})
+
+	t.Run("formats Go expression for %#v", func(t *testing.T) {
+		if got := fmt.Sprintf("%#v", maybe.Empty[[]int]()); got != "maybe.Empty[[]int]()" {
+			t.Errorf("unexpected GoString %s", got)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package maybe
+
+// Number is the set of built-in integer and floating-point types, including named types
+// derived from them.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Add returns Just(a + b) when both are Some.
+//
+// Behavior:
+//   - If both are Some: returns Just(a + b)
+//   - If either is Failure: returns the first Failure, even if an earlier argument is None
+//   - Otherwise: returns None
+//
+// Integer overflow wraps as it does for the + operator.
+//
+// Example:
+//
+//	total := Add(Just(2), Just(3))        // Just(5)
+//	total := Add(Just(2), Empty[int]())   // Empty[int]()
+func Add[N Number](a, b Maybe[N]) Maybe[N] {
+	return combine(a, b, func(x, y N) N { return x + y })
+}
+
+// Sub returns Just(a - b) when both are Some; otherwise it propagates like Add.
+//
+// Example:
+//
+//	delta := Sub(current, previous)
+func Sub[N Number](a, b Maybe[N]) Maybe[N] {
+	return combine(a, b, func(x, y N) N { return x - y })
+}
+
+// Mul returns Just(a * b) when both are Some; otherwise it propagates like Add.
+//
+// Example:
+//
+//	cost := Mul(unitPrice, quantity)
+func Mul[N Number](a, b Maybe[N]) Maybe[N] {
+	return combine(a, b, func(x, y N) N { return x * y })
+}
+
+func combine[N Number](a, b Maybe[N], op func(x, y N) N) Maybe[N] {
+	return Map2(a, b, op)
+}
+
+// SumMaybes adds up the Some values in ms, treating None as a missing measurement.
+//
+// Behavior:
+//   - If any element is Failure: returns the first Failure
+//   - If at least one element is Some: returns Just(sum of the Some values)
+//   - If ms is empty or every element is None: returns None
+//
+// Example:
+//
+//	latency := SumMaybes([]Maybe[float64]{Just(1.5), Empty[float64](), Just(2.0)}) // Just(3.5)
+func SumMaybes[N Number](ms []Maybe[N]) Maybe[N] {
+	var sum N
+	found := false
+	for _, m := range ms {
+		v, ok, err := m.Get()
+		if err != nil {
+			return m
+		}
+		if ok {
+			sum += v
+			found = true
+		}
+	}
+	if !found {
+		return Empty[N]()
+	}
+	return Just(sum)
+}
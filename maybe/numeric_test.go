@@ -0,0 +1,94 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+type cents int64
+
+func TestAdd(t *testing.T) {
+	t.Run("adds two Some values", func(t *testing.T) {
+		if v, _, _ := maybe.Add[int](maybe.Just(2), maybe.Just(3)).Get(); v != 5 {
+			t.Errorf("expected 5, got %d", v)
+		}
+	})
+
+	t.Run("supports named numeric types", func(t *testing.T) {
+		if v, _, _ := maybe.Add[cents](maybe.Just(cents(150)), maybe.Just(cents(50))).Get(); v != 200 {
+			t.Errorf("expected 200, got %d", v)
+		}
+	})
+
+	t.Run("propagates Failure before None", func(t *testing.T) {
+		err := errors.New("sensor offline")
+		none := maybe.Empty[int]()
+		failure := maybe.Failed[int](err)
+
+		if _, _, got := maybe.Add[int](none, failure).Get(); got != err {
+			t.Errorf("expected Failure to win over an earlier None, got %v", got)
+		}
+		if m := maybe.Add[int](maybe.Just(1), none); !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+		if _, _, got := maybe.Add[int](maybe.Just(1), failure).Get(); got != err {
+			t.Errorf("expected Failure from second argument, got %v", got)
+		}
+		if _, _, got := maybe.Add[int](failure, none).Get(); got != err {
+			t.Errorf("expected Failure from first argument, got %v", got)
+		}
+	})
+}
+
+func TestSub(t *testing.T) {
+	if v, _, _ := maybe.Sub[float64](maybe.Just(5.5), maybe.Just(2.0)).Get(); v != 3.5 {
+		t.Errorf("expected 3.5, got %v", v)
+	}
+	if _, ok := maybe.Sub[int](maybe.Just(1), maybe.Empty[int]()).(maybe.None[int]); !ok {
+		t.Error("expected None")
+	}
+}
+
+func TestMul(t *testing.T) {
+	if v, _, _ := maybe.Mul[uint8](maybe.Just[uint8](6), maybe.Just[uint8](7)).Get(); v != 42 {
+		t.Errorf("expected 42, got %d", v)
+	}
+	if _, ok := maybe.Mul[int](maybe.Empty[int](), maybe.Just(2)).(maybe.None[int]); !ok {
+		t.Error("expected None")
+	}
+}
+
+func TestSumMaybes(t *testing.T) {
+	t.Run("sums Some values and skips None", func(t *testing.T) {
+		ms := []maybe.Maybe[float64]{maybe.Just(1.5), maybe.Empty[float64](), maybe.Just(2.0)}
+		if v, _, _ := maybe.SumMaybes(ms).Get(); v != 3.5 {
+			t.Errorf("expected 3.5, got %v", v)
+		}
+	})
+
+	t.Run("returns first Failure", func(t *testing.T) {
+		err := errors.New("corrupt sample")
+		ms := []maybe.Maybe[int]{maybe.Just(1), maybe.Failed[int](err), maybe.Failed[int](errors.New("later"))}
+		if _, _, got := maybe.SumMaybes(ms).Get(); got != err {
+			t.Errorf("expected first Failure, got %v", got)
+		}
+	})
+
+	t.Run("returns None without any Some", func(t *testing.T) {
+		if _, ok := maybe.SumMaybes([]maybe.Maybe[int]{maybe.Empty[int]()}).(maybe.None[int]); !ok {
+			t.Error("expected None for all-None input")
+		}
+		if _, ok := maybe.SumMaybes[int](nil).(maybe.None[int]); !ok {
+			t.Error("expected None for empty input")
+		}
+	})
+
+	t.Run("returns Just(0) when values sum to zero", func(t *testing.T) {
+		ms := []maybe.Maybe[int]{maybe.Just(2), maybe.Just(-2)}
+		if v, ok, _ := maybe.SumMaybes(ms).Get(); !ok || v != 0 {
+			t.Errorf("expected Just(0), got %d (ok=%v)", v, ok)
+		}
+	})
+}
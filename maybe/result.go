@@ -0,0 +1,53 @@
+package maybe
+
+// Result is a value or an error, for code that treats absence as an error and so has no use
+// for a third state. Convert between the two with Maybe.ToResult and Result.ToMaybe.
+//
+// The zero Result is Ok with the zero value of T.
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+// Ok creates a successful Result holding v.
+//
+// Example:
+//
+//	r := Ok(42)
+func Ok[T any](v T) Result[T] {
+	return Result[T]{v: v}
+}
+
+// Err creates a failed Result holding err. A nil err creates an Ok Result with the zero value.
+//
+// Example:
+//
+//	r := Err[User](ErrNotFound)
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Get returns the value and error of the Result in Go's standard (T, error) form.
+// The value is the zero value of T when the Result is an error.
+//
+// Example:
+//
+//	user, err := findUser(id).ToResult(ErrNotFound).Get()
+func (r Result[T]) Get() (T, error) {
+	return r.v, r.err
+}
+
+// IsOk reports whether the Result holds a value rather than an error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// ToMaybe converts the Result into a Maybe: Just(value) when Ok, and a Failure with the same
+// error otherwise. A Result never converts to None.
+//
+// Example:
+//
+//	name := Map(r.ToMaybe(), func(u User) string { return u.Name })
+func (r Result[T]) ToMaybe() Maybe[T] {
+	return ToMaybe(r.v, r.err)
+}
@@ -0,0 +1,54 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestResult(t *testing.T) {
+	t.Run("Ok holds a value", func(t *testing.T) {
+		r := maybe.Ok("a")
+		if v, err := r.Get(); !r.IsOk() || v != "a" || err != nil {
+			t.Errorf("expected Ok(a), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("Err holds an error", func(t *testing.T) {
+		cause := errors.New("boom")
+		r := maybe.Err[string](cause)
+		if v, err := r.Get(); r.IsOk() || v != "" || err != cause {
+			t.Errorf("expected Err(boom), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("zero Result and Err(nil) are Ok", func(t *testing.T) {
+		if !(maybe.Result[int]{}).IsOk() || !maybe.Err[int](nil).IsOk() {
+			t.Error("expected Ok")
+		}
+	})
+}
+
+func TestResult_ToMaybe(t *testing.T) {
+	t.Run("converts Ok to Some", func(t *testing.T) {
+		if v, ok, _ := maybe.Ok(5).ToMaybe().Get(); !ok || v != 5 {
+			t.Errorf("expected Just(5), got %v", v)
+		}
+	})
+
+	t.Run("converts Err to Failure with same error", func(t *testing.T) {
+		cause := errors.New("boom")
+		if !maybe.Err[int](cause).ToMaybe().ErrIs(cause) {
+			t.Error("expected Failure with original error")
+		}
+	})
+
+	t.Run("round-trips Failure through Result", func(t *testing.T) {
+		cause := errors.New("boom")
+		m := maybe.Failed[int](cause).ToResult(errors.New("absent")).ToMaybe()
+		if _, _, err := m.Get(); err != cause {
+			t.Errorf("expected original error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package maybe
+
+import "github.com/lonelywolflee/lw-project-fp-go/randsrc"
+
+// KeepIfSampled returns a predicate that keeps a value with probability p, drawing from
+// randsrc.Default. Use it with Filter for sampled logging or tracing branches, where only a
+// fraction of the values flowing through a pipeline should take the expensive path.
+//
+// Behavior:
+//   - p <= 0: the predicate always returns false
+//   - p >= 1: the predicate always returns true, without drawing
+//   - Otherwise: each call independently returns true with probability p
+//
+// Example:
+//
+//	req.Filter(KeepIfSampled[Request](0.01)).Then(func(r Request) {
+//	    tracer.Record(r) // roughly 1% of requests
+//	})
+func KeepIfSampled[T any](p float64) func(T) bool {
+	return KeepIfSampledFrom[T](randsrc.Default, p)
+}
+
+// KeepIfSampledFrom is KeepIfSampled drawing from src, so tests can use a seeded randsrc.New.
+// A nil src uses randsrc.Default.
+//
+// Example:
+//
+//	sampled := KeepIfSampledFrom[Event](randsrc.New(1), 0.5) // same decisions on every run
+func KeepIfSampledFrom[T any](src randsrc.Source, p float64) func(T) bool {
+	src = randsrc.OrDefault(src)
+	return func(T) bool {
+		switch {
+		case p >= 1:
+			return true
+		case p > 0:
+			return src.Float64() < p
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package maybe_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/randsrc"
+)
+
+func TestKeepIfSampled(t *testing.T) {
+	t.Run("keeps everything at p = 1", func(t *testing.T) {
+		keep := maybe.KeepIfSampled[int](1)
+		for i := range 100 {
+			if !keep(i) {
+				t.Fatal("expected every value to be kept")
+			}
+		}
+	})
+
+	t.Run("works with Filter", func(t *testing.T) {
+		if _, ok := maybe.Just(1).Filter(maybe.KeepIfSampled[int](0)).(maybe.None[int]); !ok {
+			t.Error("expected value to be filtered out at p = 0")
+		}
+	})
+}
+
+func TestKeepIfSampledFrom(t *testing.T) {
+	t.Run("keeps about p of the values", func(t *testing.T) {
+		keep := maybe.KeepIfSampledFrom[int](randsrc.New(3), 0.25)
+		kept := 0
+		for i := range 4000 {
+			if keep(i) {
+				kept++
+			}
+		}
+		if kept < 800 || kept > 1200 {
+			t.Errorf("expected about 1000 kept, got %d", kept)
+		}
+	})
+
+	t.Run("is reproducible with a seeded source", func(t *testing.T) {
+		a := maybe.KeepIfSampledFrom[int](randsrc.New(9), 0.5)
+		b := maybe.KeepIfSampledFrom[int](randsrc.New(9), 0.5)
+		for i := range 50 {
+			if a(i) != b(i) {
+				t.Fatalf("decision %d differs", i)
+			}
+		}
+	})
+
+	t.Run("never keeps at p <= 0 or NaN", func(t *testing.T) {
+		for _, p := range []float64{0, -1, math.NaN()} {
+			keep := maybe.KeepIfSampledFrom[int](nil, p)
+			for i := range 100 {
+				if keep(i) {
+					t.Fatalf("p = %v: expected nothing kept", p)
+				}
+			}
+		}
+	})
+}
@@ -1,5 +1,10 @@
 package maybe
 
+import (
+	"fmt"
+	"reflect"
+)
+
 // Some represents a Maybe that contains a value.
 // It is one of the three concrete implementations of the Maybe interface.
 // Some wraps a non-nil value and provides transformation methods that operate on this value.
@@ -50,6 +55,38 @@ func (s Some[T]) MapIfFailed(fn func(error) (T, error)) Maybe[T] {
 	return s
 }
 
+// MapError returns the original Some unchanged since there is no error to rewrite.
+//
+// Example:
+//
+//	some := Just(42)
+//	result := some.MapError(func(err error) error {
+//	    return fmt.Errorf("context: %w", err) // This function is never called
+//	}) // Just(42)
+func (s Some[T]) MapError(fn func(error) error) Maybe[T] {
+	return s
+}
+
+// MapSoft applies fn to the value inside Some. If fn returns an error or panics, the error is
+// passed to onError and the original Some is returned, so the chain keeps its value.
+// If onError panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	result := Just(order).MapSoft(attachGeo, logWarning) // Just(order) even if attachGeo fails
+func (s Some[T]) MapSoft(fn func(T) (T, error), onError func(error)) Maybe[T] {
+	v, err := Try(func() (T, error) {
+		return fn(s.v)
+	}).OrError()
+	if err == nil {
+		return Just(v)
+	}
+	return Do(func() Maybe[T] {
+		onError(err)
+		return s
+	})
+}
+
 // FlatMap applies the given function to the value inside Some.
 // Unlike Map, the function is expected to return a Maybe[T], which prevents nested Maybe structures.
 // The function must return Maybe[T] (for type conversion, use the helper FlatMap function).
@@ -92,6 +129,49 @@ func (s Some[T]) Filter(fn func(T) bool) Maybe[T] {
 	})
 }
 
+// FilterErr applies a fallible predicate to the value inside Some.
+// If the predicate returns true, the same Some is returned; if it returns false, None.
+// If the predicate returns an error or panics, the result is a Failure.
+//
+// Example:
+//
+//	some := Just(5)
+//	result := some.FilterErr(func(x int) (bool, error) { return x > 0, nil }) // Just(5)
+func (s Some[T]) FilterErr(fn func(T) (bool, error)) Maybe[T] {
+	keep, err := Try(func() (bool, error) {
+		return fn(s.v)
+	}).OrError()
+	switch {
+	case err != nil:
+		return Failed[T](err)
+	case keep:
+		return s
+	default:
+		return Empty[T]()
+	}
+}
+
+// Assert applies pred to the value inside Some. If pred returns false, the invariant is
+// violated and the result is Failure(*InvariantError), or a panic in fpdev builds.
+// If pred panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	some := Just(5)
+//	result := some.Assert(func(x int) bool { return x > 0 }, "count must be positive") // Just(5)
+func (s Some[T]) Assert(pred func(T) bool, msg string) Maybe[T] {
+	holds, err := Try(func() (bool, error) {
+		return pred(s.v), nil
+	}).OrError()
+	if err != nil {
+		return Failed[T](err)
+	}
+	if !holds {
+		return invariantViolated[T](&InvariantError{Msg: msg})
+	}
+	return s
+}
+
 // Then applies the given function to the value inside Some.
 // If the function panics, the panic is caught and converted to a Failure.
 //
@@ -106,6 +186,26 @@ func (s Some[T]) Then(fn func(T)) Maybe[T] {
 	})
 }
 
+// TapNone ignores the given function and returns the original Some.
+//
+// Example:
+//
+//	some := Just(5)
+//	result := some.TapNone(func() { println("missing") }) // Just(5), nothing printed
+func (s Some[T]) TapNone(fn func()) Maybe[T] {
+	return s
+}
+
+// TapError ignores the given function and returns the original Some.
+//
+// Example:
+//
+//	some := Just(5)
+//	result := some.TapError(func(err error) { println(err) }) // Just(5), nothing printed
+func (s Some[T]) TapError(fn func(error)) Maybe[T] {
+	return s
+}
+
 // Get returns the value inside Some with presence flag true and no error.
 //
 // Example:
@@ -151,6 +251,33 @@ func (s Some[T]) OrPanic() T {
 	return s.v
 }
 
+// Expect returns the value inside Some. It never panics.
+//
+// Example:
+//
+//	value := Just(42).Expect("answer must be known") // returns 42
+func (s Some[T]) Expect(msg string) T {
+	return s.v
+}
+
+// MustGet returns the value inside Some. It never panics.
+//
+// Example:
+//
+//	value := Just(42).MustGet() // returns 42
+func (s Some[T]) MustGet() T {
+	return s.v
+}
+
+// GetOrZero returns the value inside Some.
+//
+// Example:
+//
+//	value := Just(42).GetOrZero() // returns 42
+func (s Some[T]) GetOrZero() T {
+	return s.v
+}
+
 // OrError converts Some to Go's standard (T, error) tuple.
 // Since Some contains a value, it returns (value, nil) with no error.
 // This enables seamless integration with Go's idiomatic error handling.
@@ -168,6 +295,15 @@ func (s Some[T]) OrError() (T, error) {
 	return s.v, nil
 }
 
+// ToResult returns Ok with the value inside Some.
+//
+// Example:
+//
+//	r := Just(42).ToResult(ErrNotFound) // Ok(42)
+func (s Some[T]) ToResult(noneErr error) Result[T] {
+	return Ok(s.v)
+}
+
 // MatchThen applies the given functions based on the type of Maybe.
 // If Maybe is Some, the some function is called with the value inside Some.
 // If Maybe is None, the none function is called.
@@ -185,3 +321,79 @@ func (s Some[T]) MatchThen(someFn func(T), noneFn func(), failureFn func(error))
 		return s
 	})
 }
+
+// Accept calls the visitor's VisitSome method and returns the original Some.
+// If the visitor panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	result := Just(5).Accept(visitor) // VisitSome is called with 5
+func (s Some[T]) Accept(v Visitor[T]) Maybe[T] {
+	return Do(func() Maybe[T] {
+		v.VisitSome(s.v)
+		return s
+	})
+}
+
+// ErrIs always returns false because Some holds no error.
+//
+// Example:
+//
+//	Just(5).ErrIs(ErrNotFound) // false
+func (s Some[T]) ErrIs(target error) bool {
+	return false
+}
+
+// IsSome always returns true for Some.
+//
+// Example:
+//
+//	Just(5).IsSome() // true
+func (s Some[T]) IsSome() bool {
+	return true
+}
+
+// IsNone always returns false for Some.
+//
+// Example:
+//
+//	Just(5).IsNone() // false
+func (s Some[T]) IsNone() bool {
+	return false
+}
+
+// IsFailed always returns false for Some.
+//
+// Example:
+//
+//	Just(5).IsFailed() // false
+func (s Some[T]) IsFailed() bool {
+	return false
+}
+
+// Exists reports whether pred holds for the value inside Some.
+//
+// Example:
+//
+//	Just(5).Exists(func(x int) bool { return x > 0 }) // true
+func (s Some[T]) Exists(pred func(T) bool) bool {
+	return pred(s.v)
+}
+
+// String formats the Some as Some(value), so Maybes read clearly in logs and %v output.
+//
+// Example:
+//
+//	fmt.Println(Just(42)) // Some(42)
+func (s Some[T]) String() string {
+	return fmt.Sprintf("Some(%v)", s.v)
+}
+
+// GoString formats the Some as the Go expression that creates it, for %#v output.
+//
+// Example:
+//
+//	fmt.Printf("%#v", Just("a")) // maybe.Just[string]("a")
+func (s Some[T]) GoString() string {
+	return fmt.Sprintf("maybe.Just[%s](%#v)", reflect.TypeFor[T](), s.v)
+}
@@ -384,6 +384,37 @@ func TestSome_Filter(t *testing.T) {
 	})
 }
 
+func TestSome_FilterErr(t *testing.T) {
+	t.Run("returns Some when predicate is true", func(t *testing.T) {
+		result := maybe.Just(10).FilterErr(func(x int) (bool, error) { return x > 5, nil })
+		if v, ok, _ := result.Get(); !ok || v != 10 {
+			t.Errorf("expected Just(10), got %v", result)
+		}
+	})
+
+	t.Run("returns None when predicate is false", func(t *testing.T) {
+		result := maybe.Just(3).FilterErr(func(x int) (bool, error) { return x > 5, nil })
+		if _, ok := result.(maybe.None[int]); !ok {
+			t.Errorf("expected None, got %v", result)
+		}
+	})
+
+	t.Run("returns Failure when predicate returns error", func(t *testing.T) {
+		err := errors.New("lookup failed")
+		result := maybe.Just(10).FilterErr(func(x int) (bool, error) { return true, err })
+		if _, _, gotErr := result.Get(); gotErr != err {
+			t.Errorf("expected %v, got %v", err, gotErr)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		result := maybe.Just(10).FilterErr(func(x int) (bool, error) { panic("boom") })
+		if !result.IsFailed() {
+			t.Errorf("expected Failure, got %v", result)
+		}
+	})
+}
+
 func TestSome_Then(t *testing.T) {
 	t.Run("executes function and returns original Some", func(t *testing.T) {
 		executed := false
@@ -530,6 +561,26 @@ func TestSome_Then(t *testing.T) {
 	})
 }
 
+func TestSome_TapNone(t *testing.T) {
+	t.Run("returns Some without calling function", func(t *testing.T) {
+		called := false
+		result := maybe.Just(5).TapNone(func() { called = true })
+		if v, ok, _ := result.Get(); !ok || v != 5 || called {
+			t.Errorf("expected Just(5) without call, got %v (called=%v)", result, called)
+		}
+	})
+}
+
+func TestSome_TapError(t *testing.T) {
+	t.Run("returns Some without calling function", func(t *testing.T) {
+		called := false
+		result := maybe.Just(5).TapError(func(error) { called = true })
+		if v, ok, _ := result.Get(); !ok || v != 5 || called {
+			t.Errorf("expected Just(5) without call, got %v (called=%v)", result, called)
+		}
+	})
+}
+
 func TestSome_OrElseGet(t *testing.T) {
 	t.Run("returns the value and does not call function", func(t *testing.T) {
 		some := maybe.Just(42)
@@ -890,6 +941,59 @@ func TestSome_MapIfFailed(t *testing.T) {
 	})
 }
 
+func TestSome_MapError(t *testing.T) {
+	t.Run("returns Some without calling function", func(t *testing.T) {
+		called := false
+		result := maybe.Just(42).MapError(func(err error) error {
+			called = true
+			return err
+		})
+		if v, ok, _ := result.Get(); !ok || v != 42 || called {
+			t.Errorf("expected Just(42) without call, got %v (called=%v)", result, called)
+		}
+	})
+}
+
+func TestSome_MapSoft(t *testing.T) {
+	t.Run("returns new value when fn succeeds", func(t *testing.T) {
+		result := maybe.Just(5).MapSoft(func(x int) (int, error) { return x * 2, nil }, func(error) {
+			t.Error("onError should not be called")
+		})
+		if v, ok, _ := result.Get(); !ok || v != 10 {
+			t.Errorf("expected Just(10), got %v", result)
+		}
+	})
+
+	t.Run("keeps original value and reports error", func(t *testing.T) {
+		errGeo := errors.New("geo lookup failed")
+		var warnings []error
+		result := maybe.Just(5).MapSoft(func(int) (int, error) { return 0, errGeo }, func(err error) {
+			warnings = append(warnings, err)
+		})
+		if v, ok, _ := result.Get(); !ok || v != 5 {
+			t.Errorf("expected Just(5), got %v", result)
+		}
+		if len(warnings) != 1 || warnings[0] != errGeo {
+			t.Errorf("expected one warning, got %v", warnings)
+		}
+	})
+
+	t.Run("reports panic in fn as warning", func(t *testing.T) {
+		var warned error
+		result := maybe.Just(5).MapSoft(func(int) (int, error) { panic("boom") }, func(err error) { warned = err })
+		if v, ok, _ := result.Get(); !ok || v != 5 || warned == nil {
+			t.Errorf("expected Just(5) with warning, got %v %v", result, warned)
+		}
+	})
+
+	t.Run("converts panic in onError to Failure", func(t *testing.T) {
+		result := maybe.Just(5).MapSoft(func(int) (int, error) { return 0, errors.New("x") }, func(error) { panic("bad sink") })
+		if !result.IsFailed() {
+			t.Errorf("expected Failure, got %v", result)
+		}
+	})
+}
+
 func TestSome_OrPanic(t *testing.T) {
 	t.Run("returns the value without panic", func(t *testing.T) {
 		some := maybe.Just(42)
@@ -946,6 +1050,23 @@ func TestSome_OrPanic(t *testing.T) {
 	})
 }
 
+func TestSome_Expect(t *testing.T) {
+	t.Run("returns value without panicking", func(t *testing.T) {
+		if got := maybe.Just(42).Expect("answer must be known"); got != 42 {
+			t.Errorf("expected 42, got %d", got)
+		}
+	})
+}
+
+func TestSome_MustGetAndGetOrZero(t *testing.T) {
+	t.Run("return the value", func(t *testing.T) {
+		some := maybe.Just(42)
+		if some.MustGet() != 42 || some.GetOrZero() != 42 {
+			t.Errorf("expected 42 from both, got %d and %d", some.MustGet(), some.GetOrZero())
+		}
+	})
+}
+
 func TestSome_OrError(t *testing.T) {
 	t.Run("returns value with nil error", func(t *testing.T) {
 		some := maybe.Just(42)
@@ -1030,3 +1151,122 @@ func TestSome_OrError(t *testing.T) {
 		}
 	})
 }
+
+func TestSome_ToResult(t *testing.T) {
+	t.Run("returns Ok with value", func(t *testing.T) {
+		r := maybe.Just(42).ToResult(errors.New("unused"))
+		if v, err := r.Get(); !r.IsOk() || v != 42 || err != nil {
+			t.Errorf("expected Ok(42), got (%v, %v)", v, err)
+		}
+	})
+}
+
+// recordingVisitor records which Visitor method was called and with what.
+type recordingVisitor[T any] struct {
+	called string
+	value  T
+	err    error
+	panics bool
+}
+
+func (v *recordingVisitor[T]) VisitSome(x T) {
+	v.called, v.value = "some", x
+	v.maybePanic()
+}
+
+func (v *recordingVisitor[T]) VisitNone() {
+	v.called = "none"
+	v.maybePanic()
+}
+
+func (v *recordingVisitor[T]) VisitFailure(err error) {
+	v.called, v.err = "failure", err
+	v.maybePanic()
+}
+
+func (v *recordingVisitor[T]) maybePanic() {
+	if v.panics {
+		panic("visitor panic")
+	}
+}
+
+func TestSome_Accept(t *testing.T) {
+	t.Run("calls VisitSome with the value", func(t *testing.T) {
+		visitor := &recordingVisitor[int]{}
+		result := maybe.Just(42).Accept(visitor)
+
+		if visitor.called != "some" || visitor.value != 42 {
+			t.Errorf("expected VisitSome(42), got %s(%d)", visitor.called, visitor.value)
+		}
+		if v, ok := result.(maybe.Some[int]); !ok || v.OrPanic() != 42 {
+			t.Error("expected original Some to be returned")
+		}
+	})
+
+	t.Run("converts visitor panic to Failure", func(t *testing.T) {
+		result := maybe.Just(42).Accept(&recordingVisitor[int]{panics: true})
+
+		if _, ok := result.(maybe.Failure[int]); !ok {
+			t.Error("expected Failure when visitor panics")
+		}
+	})
+}
+
+func TestSome_ErrIs(t *testing.T) {
+	t.Run("always returns false", func(t *testing.T) {
+		if maybe.Just(1).ErrIs(errors.New("any")) {
+			t.Error("expected false for Some")
+		}
+	})
+}
+
+func TestSome_StatePredicates(t *testing.T) {
+	t.Run("reports Some state only", func(t *testing.T) {
+		m := maybe.Just(1)
+		if m.IsSome() != true || m.IsNone() != false || m.IsFailed() != false {
+			t.Errorf("unexpected predicates: IsSome=%v IsNone=%v IsFailed=%v", m.IsSome(), m.IsNone(), m.IsFailed())
+		}
+	})
+}
+
+func TestSome_Exists(t *testing.T) {
+	t.Run("reports whether predicate holds", func(t *testing.T) {
+		some := maybe.Just(5)
+		if !some.Exists(func(x int) bool { return x > 0 }) {
+			t.Error("expected true for matching predicate")
+		}
+		if some.Exists(func(x int) bool { return x > 10 }) {
+			t.Error("expected false for non-matching predicate")
+		}
+	})
+}
+
+func TestSome_Assert(t *testing.T) {
+	t.Run("returns Some when invariant holds", func(t *testing.T) {
+		result := maybe.Just(5).Assert(func(x int) bool { return x > 0 }, "must be positive")
+		if v, ok, _ := result.Get(); !ok || v != 5 {
+			t.Errorf("expected Just(5), got %v", result)
+		}
+	})
+
+	t.Run("converts predicate panic to Failure", func(t *testing.T) {
+		result := maybe.Just(5).Assert(func(x int) bool { panic("bad predicate") }, "must be positive")
+		if _, _, err := result.Get(); err == nil || errors.As(err, new(*maybe.InvariantError)) {
+			t.Errorf("expected panic Failure, got %v", err)
+		}
+	})
+}
+
+func TestSome_String(t *testing.T) {
+	t.Run("formats value for %v", func(t *testing.T) {
+		if got := fmt.Sprint(maybe.Just(42)); got != "Some(42)" {
+			t.Errorf("expected Some(42), got %s", got)
+		}
+	})
+
+	t.Run("formats Go expression for %#v", func(t *testing.T) {
+		if got := fmt.Sprintf("%#v", maybe.Just("a")); got != `maybe.Just[string]("a")` {
+			t.Errorf("unexpected GoString %s", got)
+		}
+	})
+}
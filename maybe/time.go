@@ -0,0 +1,61 @@
+package maybe
+
+import "time"
+
+// Latest returns the latest of the Some timestamps in ms, treating None as unknown.
+//
+// Behavior:
+//   - If any element is Failure: returns the first Failure
+//   - If at least one element is Some: returns Just(latest time)
+//   - If ms is empty or every element is None: returns None
+//
+// Example:
+//
+//	lastSeen := Latest(session.LastActivity, device.LastSync, Empty[time.Time]())
+func Latest(ms ...Maybe[time.Time]) Maybe[time.Time] {
+	return pickTime(ms, time.Time.After)
+}
+
+// Earliest returns the earliest of the Some timestamps in ms, following the same rules as Latest.
+//
+// Example:
+//
+//	expiry := Earliest(token.ExpiresAt, session.ExpiresAt) // whichever ends first
+func Earliest(ms ...Maybe[time.Time]) Maybe[time.Time] {
+	return pickTime(ms, time.Time.Before)
+}
+
+func pickTime(ms []Maybe[time.Time], better func(a, b time.Time) bool) Maybe[time.Time] {
+	var best time.Time
+	found := false
+	for _, m := range ms {
+		t, ok, err := m.Get()
+		if err != nil {
+			return m
+		}
+		if ok && (!found || better(t, best)) {
+			best, found = t, true
+		}
+	}
+	if !found {
+		return Empty[time.Time]()
+	}
+	return Just(best)
+}
+
+// ExpiredBy returns a predicate reporting whether an expiry time has been reached at now,
+// that is, whether it is not after now. Use it with Filter to keep only expired (or, negated,
+// only live) timestamps.
+//
+// Example:
+//
+//	expired := token.ExpiresAt.Filter(ExpiredBy(time.Now())) // Some only if already expired
+//
+//	if expired.IsSome() {
+//	    refresh()
+//	}
+func ExpiredBy(now time.Time) func(time.Time) bool {
+	return func(expiry time.Time) bool {
+		return !expiry.After(now)
+	}
+}
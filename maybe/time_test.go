@@ -0,0 +1,80 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+var (
+	tsEarly = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tsMid   = tsEarly.Add(time.Hour)
+	tsLate  = tsEarly.Add(2 * time.Hour)
+)
+
+func TestLatest(t *testing.T) {
+	t.Run("returns latest Some and skips None", func(t *testing.T) {
+		result := maybe.Latest(maybe.Just(tsMid), maybe.Empty[time.Time](), maybe.Just(tsLate), maybe.Just(tsEarly))
+		if v, _, _ := result.Get(); !v.Equal(tsLate) {
+			t.Errorf("expected %v, got %v", tsLate, v)
+		}
+	})
+
+	t.Run("returns first Failure", func(t *testing.T) {
+		err := errors.New("unparseable timestamp")
+		if _, _, got := maybe.Latest(maybe.Just(tsEarly), maybe.Failed[time.Time](err)).Get(); got != err {
+			t.Errorf("expected Failure, got %v", got)
+		}
+	})
+
+	t.Run("returns None without any Some", func(t *testing.T) {
+		if _, ok := maybe.Latest().(maybe.None[time.Time]); !ok {
+			t.Error("expected None for no arguments")
+		}
+		if _, ok := maybe.Latest(maybe.Empty[time.Time]()).(maybe.None[time.Time]); !ok {
+			t.Error("expected None for all-None input")
+		}
+	})
+}
+
+func TestEarliest(t *testing.T) {
+	t.Run("returns earliest Some", func(t *testing.T) {
+		result := maybe.Earliest(maybe.Just(tsMid), maybe.Just(tsEarly), maybe.Empty[time.Time](), maybe.Just(tsLate))
+		if v, _, _ := result.Get(); !v.Equal(tsEarly) {
+			t.Errorf("expected %v, got %v", tsEarly, v)
+		}
+	})
+
+	t.Run("returns None without any Some", func(t *testing.T) {
+		if _, ok := maybe.Earliest(maybe.Empty[time.Time]()).(maybe.None[time.Time]); !ok {
+			t.Error("expected None")
+		}
+	})
+}
+
+func TestExpiredBy(t *testing.T) {
+	expired := maybe.ExpiredBy(tsMid)
+
+	t.Run("reports past and current expiries as expired", func(t *testing.T) {
+		if !expired(tsEarly) || !expired(tsMid) {
+			t.Error("expected expiry at or before now to be expired")
+		}
+	})
+
+	t.Run("reports future expiry as live", func(t *testing.T) {
+		if expired(tsLate) {
+			t.Error("expected future expiry not to be expired")
+		}
+	})
+
+	t.Run("works with Filter", func(t *testing.T) {
+		if _, ok := maybe.Just(tsLate).Filter(expired).(maybe.None[time.Time]); !ok {
+			t.Error("expected live token to be filtered out")
+		}
+		if _, ok := maybe.Just(tsEarly).Filter(expired).(maybe.Some[time.Time]); !ok {
+			t.Error("expected expired token to be kept")
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package maybe
+
+// Tuple2 holds two values, such as those combined by Zip2 or the halves from strfp.CutMaybe.
+type Tuple2[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Tuple3 holds three values combined by Zip3.
+type Tuple3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip2 combines two independent Maybes into one holding both values.
+//
+// Behavior:
+//   - If any argument is Failure: returns the first Failure, even if an earlier argument is None
+//   - Otherwise, if any argument is None: returns None
+//   - If both are Some: returns Just(Tuple2{First: a, Second: b})
+//
+// Example:
+//
+//	pair := Zip2(findUser(id), loadConfig())
+//	pair.Then(func(t Tuple2[User, Config]) {
+//	    render(t.First, t.Second)
+//	})
+func Zip2[A, B any](ma Maybe[A], mb Maybe[B]) Maybe[Tuple2[A, B]] {
+	a, okA, errA := ma.Get()
+	b, okB, errB := mb.Get()
+	if err := firstError(errA, errB); err != nil {
+		return Failed[Tuple2[A, B]](err)
+	}
+	if !okA || !okB {
+		return Empty[Tuple2[A, B]]()
+	}
+	return Just(Tuple2[A, B]{First: a, Second: b})
+}
+
+// Zip3 combines three independent Maybes into one holding all three values,
+// with the same Failure-then-None precedence as Zip2.
+//
+// Example:
+//
+//	all := Zip3(findUser(id), loadConfig(), issueToken(id))
+//	// Just(Tuple3{user, config, token}) only when all three are Some
+func Zip3[A, B, C any](ma Maybe[A], mb Maybe[B], mc Maybe[C]) Maybe[Tuple3[A, B, C]] {
+	a, okA, errA := ma.Get()
+	b, okB, errB := mb.Get()
+	c, okC, errC := mc.Get()
+	if err := firstError(errA, errB, errC); err != nil {
+		return Failed[Tuple3[A, B, C]](err)
+	}
+	if !okA || !okB || !okC {
+		return Empty[Tuple3[A, B, C]]()
+	}
+	return Just(Tuple3[A, B, C]{First: a, Second: b, Third: c})
+}
+
+// Map2 applies fn to the values of two independent Maybes, with the same Failure-then-None
+// precedence as Zip2. It reads better than nesting FlatMap when a result needs several inputs.
+// If fn panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	price := Map2(quantity, unitPrice, func(q int, p Cents) Cents {
+//	    return Cents(q) * p
+//	}) // Just(total) only when both are Some
+func Map2[A, B, R any](ma Maybe[A], mb Maybe[B], fn func(A, B) R) Maybe[R] {
+	return Map(Zip2(ma, mb), func(t Tuple2[A, B]) R {
+		return fn(t.First, t.Second)
+	})
+}
+
+// Map3 applies fn to the values of three independent Maybes, with the same
+// Failure-then-None precedence as Zip3.
+// If fn panics, the panic is caught and converted to a Failure.
+//
+// Example:
+//
+//	invoice := Map3(findCustomer(id), loadOrder(orderID), currentRates(), buildInvoice)
+func Map3[A, B, C, R any](ma Maybe[A], mb Maybe[B], mc Maybe[C], fn func(A, B, C) R) Maybe[R] {
+	return Map(Zip3(ma, mb, mc), func(t Tuple3[A, B, C]) R {
+		return fn(t.First, t.Second, t.Third)
+	})
+}
+
+// Ap applies the function inside mf to the value inside mt, for applicative pipelines that
+// build up a function one argument at a time. Precedence matches Zip2: a Failure in mf wins
+// over one in mt, and any Failure wins over None.
+// If the function is nil or panics, the result is a Failure.
+//
+// Example:
+//
+//	addTax := Map(taxRate, func(rate float64) func(Cents) Cents {
+//	    return func(c Cents) Cents { return c + Cents(float64(c)*rate) }
+//	})
+//	total := Ap(addTax, subtotal) // Just(total) only when both are Some
+func Ap[T, R any](mf Maybe[func(T) R], mt Maybe[T]) Maybe[R] {
+	return Map2(mf, mt, func(f func(T) R, v T) R {
+		return f(v)
+	})
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
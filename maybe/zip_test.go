@@ -0,0 +1,175 @@
+package maybe_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+func TestZip2(t *testing.T) {
+	t.Run("combines two Somes", func(t *testing.T) {
+		got := maybe.Zip2[int, string](maybe.Just(1), maybe.Just("a")).OrPanic()
+		if got.First != 1 || got.Second != "a" {
+			t.Errorf("unexpected tuple %+v", got)
+		}
+	})
+
+	t.Run("returns Failure before None", func(t *testing.T) {
+		err := errors.New("config unavailable")
+		_, _, got := maybe.Zip2(maybe.Empty[int](), maybe.Failed[string](err)).Get()
+		if got != err {
+			t.Errorf("expected %v, got %v", err, got)
+		}
+	})
+
+	t.Run("returns first Failure", func(t *testing.T) {
+		first, second := errors.New("first"), errors.New("second")
+		_, _, got := maybe.Zip2(maybe.Failed[int](first), maybe.Failed[string](second)).Get()
+		if got != first {
+			t.Errorf("expected %v, got %v", first, got)
+		}
+	})
+
+	t.Run("returns None when either is None", func(t *testing.T) {
+		for name, m := range map[string]maybe.Maybe[maybe.Tuple2[int, string]]{
+			"first":  maybe.Zip2[int, string](maybe.Empty[int](), maybe.Just("a")),
+			"second": maybe.Zip2[int, string](maybe.Just(1), maybe.Empty[string]()),
+		} {
+			if !m.IsNone() {
+				t.Errorf("%s: expected None, got %v", name, m)
+			}
+		}
+	})
+}
+
+func TestZip3(t *testing.T) {
+	t.Run("combines three Somes", func(t *testing.T) {
+		got := maybe.Zip3[int, string, bool](maybe.Just(1), maybe.Just("a"), maybe.Just(true)).OrPanic()
+		if got.First != 1 || got.Second != "a" || !got.Third {
+			t.Errorf("unexpected tuple %+v", got)
+		}
+	})
+
+	t.Run("returns Failure before None", func(t *testing.T) {
+		err := errors.New("token failed")
+		_, _, got := maybe.Zip3[int, string, bool](maybe.Just(1), maybe.Empty[string](), maybe.Failed[bool](err)).Get()
+		if got != err {
+			t.Errorf("expected %v, got %v", err, got)
+		}
+	})
+
+	t.Run("returns None when any is None", func(t *testing.T) {
+		if m := maybe.Zip3[int, string, bool](maybe.Just(1), maybe.Just("a"), maybe.Empty[bool]()); !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+	})
+}
+
+func TestMap2(t *testing.T) {
+	mul := func(q, p int) int { return q * p }
+
+	t.Run("applies fn to two Somes", func(t *testing.T) {
+		if got := maybe.Map2[int, int](maybe.Just(3), maybe.Just(250), mul).OrPanic(); got != 750 {
+			t.Errorf("expected 750, got %d", got)
+		}
+	})
+
+	t.Run("returns Failure before None without calling fn", func(t *testing.T) {
+		err := errors.New("price unavailable")
+		called := false
+		_, _, got := maybe.Map2(maybe.Empty[int](), maybe.Failed[int](err), func(q, p int) int { called = true; return 0 }).Get()
+		if got != err || called {
+			t.Errorf("expected %v without call, got %v (called=%v)", err, got, called)
+		}
+	})
+
+	t.Run("returns None when either is None", func(t *testing.T) {
+		if m := maybe.Map2[int, int](maybe.Just(3), maybe.Empty[int](), mul); !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		m := maybe.Map2[int, int](maybe.Just(1), maybe.Just(0), func(a, b int) int { return a / b })
+		if !m.IsFailed() {
+			t.Errorf("expected Failure, got %v", m)
+		}
+	})
+}
+
+func TestMap3(t *testing.T) {
+	join := func(a int, b string, c bool) string { return fmt.Sprintf("%d-%s-%v", a, b, c) }
+
+	t.Run("applies fn to three Somes", func(t *testing.T) {
+		if got := maybe.Map3[int, string, bool](maybe.Just(1), maybe.Just("a"), maybe.Just(true), join).OrPanic(); got != "1-a-true" {
+			t.Errorf("unexpected result %q", got)
+		}
+	})
+
+	t.Run("returns Failure before None", func(t *testing.T) {
+		err := errors.New("rates unavailable")
+		_, _, got := maybe.Map3[int, string, bool](maybe.Empty[int](), maybe.Just("a"), maybe.Failed[bool](err), join).Get()
+		if got != err {
+			t.Errorf("expected %v, got %v", err, got)
+		}
+	})
+
+	t.Run("returns None when any is None", func(t *testing.T) {
+		if m := maybe.Map3[int, string, bool](maybe.Just(1), maybe.Empty[string](), maybe.Just(true), join); !m.IsNone() {
+			t.Errorf("expected None, got %v", m)
+		}
+	})
+}
+
+func TestAp(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+
+	t.Run("applies wrapped function to wrapped value", func(t *testing.T) {
+		if got := maybe.Ap[int, int](maybe.Just(double), maybe.Just(21)).OrPanic(); got != 42 {
+			t.Errorf("expected 42, got %d", got)
+		}
+	})
+
+	t.Run("applies curried functions argument by argument", func(t *testing.T) {
+		add := maybe.Just(func(a int) func(int) int { return func(b int) int { return a + b } })
+		got := maybe.Ap(maybe.Ap[int, func(int) int](add, maybe.Just(1)), maybe.Just(2)).OrPanic()
+		if got != 3 {
+			t.Errorf("expected 3, got %d", got)
+		}
+	})
+
+	t.Run("returns function Failure before value Failure", func(t *testing.T) {
+		fnErr, valueErr := errors.New("no function"), errors.New("no value")
+		_, _, got := maybe.Ap(maybe.Failed[func(int) int](fnErr), maybe.Failed[int](valueErr)).Get()
+		if got != fnErr {
+			t.Errorf("expected %v, got %v", fnErr, got)
+		}
+	})
+
+	t.Run("returns Failure before None", func(t *testing.T) {
+		err := errors.New("no value")
+		_, _, got := maybe.Ap(maybe.Empty[func(int) int](), maybe.Failed[int](err)).Get()
+		if got != err {
+			t.Errorf("expected %v, got %v", err, got)
+		}
+	})
+
+	t.Run("returns None when either is None", func(t *testing.T) {
+		for name, m := range map[string]maybe.Maybe[int]{
+			"function": maybe.Ap[int, int](maybe.Empty[func(int) int](), maybe.Just(1)),
+			"value":    maybe.Ap[int, int](maybe.Just(double), maybe.Empty[int]()),
+		} {
+			if !m.IsNone() {
+				t.Errorf("%s: expected None, got %v", name, m)
+			}
+		}
+	})
+
+	t.Run("returns Failure for nil function", func(t *testing.T) {
+		if m := maybe.Ap[int, int](maybe.Just[func(int) int](nil), maybe.Just(1)); !m.IsFailed() {
+			t.Errorf("expected Failure, got %v", m)
+		}
+	})
+}
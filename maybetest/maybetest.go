@@ -0,0 +1,314 @@
+package maybetest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// TB is the subset of testing.TB used by Check. *testing.T and *testing.B satisfy it.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+var errProbe = errors.New("maybetest: probe error")
+
+// Check verifies that m honors the maybe.Maybe contract: it must behave as exactly one of
+// Some, None or Failure, as decided by its Get method, and every method must behave as the
+// built-in type for that state does. Each violation is reported with t.Errorf, prefixed by
+// the method that violated it. Run it against every state a custom implementation can be in.
+//
+// Values are compared with reflect.DeepEqual.
+//
+// Example:
+//
+//	func TestCachedConforms(t *testing.T) {
+//	    maybetest.Check(t, cached.Hit(42))
+//	    maybetest.Check(t, cached.Miss[int]())
+//	    maybetest.Check(t, cached.Error[int](io.ErrUnexpectedEOF))
+//	}
+func Check[T any](t TB, m maybe.Maybe[T]) {
+	t.Helper()
+	c := checker[T]{t: t}
+	c.value, c.ok, c.err = m.Get()
+	c.state = stateOf(c.ok, c.err)
+
+	c.checkPredicates(m)
+	c.checkExtraction(m)
+	c.checkBranching(m)
+	c.checkTransformations(m)
+}
+
+type state int
+
+const (
+	some state = iota
+	none
+	failure
+)
+
+func (s state) String() string {
+	return [...]string{"Some", "None", "Failure"}[s]
+}
+
+func stateOf(ok bool, err error) state {
+	switch {
+	case err != nil:
+		return failure
+	case ok:
+		return some
+	default:
+		return none
+	}
+}
+
+type checker[T any] struct {
+	t     TB
+	state state
+	value T
+	ok    bool
+	err   error
+}
+
+func (c checker[T]) errorf(method, format string, args ...any) {
+	c.t.Helper()
+	c.t.Errorf("%s (%s): "+format, append([]any{method, c.state}, args...)...)
+}
+
+// sameAs reports whether got is in the same state as the checked Maybe, with the same
+// value or the same error.
+func (c checker[T]) sameAs(method string, got maybe.Maybe[T]) {
+	c.t.Helper()
+	if got == nil {
+		c.errorf(method, "returned nil")
+		return
+	}
+	v, ok, err := got.Get()
+	switch {
+	case stateOf(ok, err) != c.state:
+		c.errorf(method, "expected %s, got %s", c.state, stateOf(ok, err))
+	case c.state == some && !reflect.DeepEqual(v, c.value):
+		c.errorf(method, "expected value %v, got %v", c.value, v)
+	case c.state == failure && !errors.Is(err, c.err):
+		c.errorf(method, "expected error %v, got %v", c.err, err)
+	}
+}
+
+func (c checker[T]) checkPredicates(m maybe.Maybe[T]) {
+	c.t.Helper()
+	if got := [3]bool{m.IsSome(), m.IsNone(), m.IsFailed()}; got != [3]bool{c.state == some, c.state == none, c.state == failure} {
+		c.errorf("IsSome/IsNone/IsFailed", "reported %v", got)
+	}
+	var calls []state
+	exists := m.Exists(func(v T) bool {
+		calls = append(calls, some)
+		return reflect.DeepEqual(v, c.value)
+	})
+	c.calledIf("Exists", calls, some)
+	if exists != (c.state == some) {
+		c.errorf("Exists", "reported %v", exists)
+	}
+	if c.state == failure && !m.ErrIs(c.err) {
+		c.errorf("ErrIs", "does not match its own error")
+	}
+	if c.state != failure && m.ErrIs(errProbe) {
+		c.errorf("ErrIs", "matched although there is no error")
+	}
+}
+
+func (c checker[T]) checkExtraction(m maybe.Maybe[T]) {
+	c.t.Helper()
+	var zero, fallback T
+	v, err := m.OrError()
+	switch {
+	case c.state == some && (err != nil || !reflect.DeepEqual(v, c.value)):
+		c.errorf("OrError", "expected (%v, nil), got (%v, %v)", c.value, v, err)
+	case c.state == none && err == nil:
+		c.errorf("OrError", "expected an error")
+	case c.state == failure && !errors.Is(err, c.err):
+		c.errorf("OrError", "expected error %v, got %v", c.err, err)
+	case c.state != some && !reflect.DeepEqual(v, zero):
+		c.errorf("OrError", "expected zero value, got %v", v)
+	}
+
+	noneErr := errors.New("maybetest: none")
+	rv, rerr := m.ToResult(noneErr).Get()
+	switch {
+	case c.state == some && (rerr != nil || !reflect.DeepEqual(rv, c.value)):
+		c.errorf("ToResult", "expected Ok(%v), got (%v, %v)", c.value, rv, rerr)
+	case c.state == none && rerr != noneErr:
+		c.errorf("ToResult", "expected Err(%v), got %v", noneErr, rerr)
+	case c.state == failure && !errors.Is(rerr, c.err):
+		c.errorf("ToResult", "expected error %v, got %v", c.err, rerr)
+	}
+
+	want := fallback
+	if c.state == some {
+		want = c.value
+	}
+	if got := m.OrElseDefault(fallback); !reflect.DeepEqual(got, want) {
+		c.errorf("OrElseDefault", "expected %v, got %v", want, got)
+	}
+	orZero := zero
+	if c.state == some {
+		orZero = c.value
+	}
+	if got := m.GetOrZero(); !reflect.DeepEqual(got, orZero) {
+		c.errorf("GetOrZero", "expected %v, got %v", orZero, got)
+	}
+
+	var passed error
+	called := false
+	got := m.OrElseGet(func(err error) T { called, passed = true, err; return fallback })
+	if called == (c.state == some) || !reflect.DeepEqual(got, want) || !errors.Is(passed, c.err) {
+		c.errorf("OrElseGet", "called=%v err=%v result=%v", called, passed, got)
+	}
+
+	unwraps := []struct {
+		name   string
+		unwrap func() T
+	}{{"OrPanic", m.OrPanic}, {"MustGet", m.MustGet}, {"Expect", func() T { return m.Expect("maybetest") }}}
+	for _, u := range unwraps {
+		name := u.name
+		v, err := maybe.Try(func() (T, error) { return u.unwrap(), nil }).OrError()
+		switch {
+		case c.state == some && (err != nil || !reflect.DeepEqual(v, c.value)):
+			c.errorf(name, "expected %v, got (%v, %v)", c.value, v, err)
+		case c.state != some && err == nil:
+			c.errorf(name, "did not panic")
+		case c.state == failure && !errors.Is(err, c.err):
+			c.errorf(name, "panic %v does not wrap %v", err, c.err)
+		}
+	}
+}
+
+// recorder is a Visitor that records which method was called.
+type recorder[T any] struct {
+	calls *[]state
+}
+
+func (r recorder[T]) VisitSome(T)        { *r.calls = append(*r.calls, some) }
+func (r recorder[T]) VisitNone()         { *r.calls = append(*r.calls, none) }
+func (r recorder[T]) VisitFailure(error) { *r.calls = append(*r.calls, failure) }
+
+func (c checker[T]) checkBranching(m maybe.Maybe[T]) {
+	c.t.Helper()
+	var calls []state
+	result := m.MatchThen(
+		func(v T) {
+			calls = append(calls, some)
+			if !reflect.DeepEqual(v, c.value) {
+				c.errorf("MatchThen", "someFn received %v", v)
+			}
+		},
+		func() { calls = append(calls, none) },
+		func(err error) {
+			calls = append(calls, failure)
+			if !errors.Is(err, c.err) {
+				c.errorf("MatchThen", "failureFn received %v", err)
+			}
+		},
+	)
+	c.calledOnly("MatchThen", calls)
+	c.sameAs("MatchThen", result)
+
+	calls = nil
+	result = m.Accept(recorder[T]{calls: &calls})
+	c.calledOnly("Accept", calls)
+	c.sameAs("Accept", result)
+
+	calls = nil
+	result = m.Then(func(T) { calls = append(calls, some) })
+	c.calledIf("Then", calls, some)
+	c.sameAs("Then", result)
+
+	calls = nil
+	result = m.TapNone(func() { calls = append(calls, none) })
+	c.calledIf("TapNone", calls, none)
+	c.sameAs("TapNone", result)
+
+	calls = nil
+	result = m.TapError(func(err error) {
+		calls = append(calls, failure)
+		if !errors.Is(err, c.err) {
+			c.errorf("TapError", "fn received %v", err)
+		}
+	})
+	c.calledIf("TapError", calls, failure)
+	c.sameAs("TapError", result)
+}
+
+// calledOnly checks that exactly the branch for the checked state ran, once.
+func (c checker[T]) calledOnly(method string, calls []state) {
+	c.t.Helper()
+	if len(calls) != 1 || calls[0] != c.state {
+		c.errorf(method, "expected one %s branch call, got %v", c.state, calls)
+	}
+}
+
+// calledIf checks that a callback ran once if the checked state is s, and never otherwise.
+func (c checker[T]) calledIf(method string, calls []state, s state) {
+	c.t.Helper()
+	if want := c.state == s; (len(calls) == 1) != want || len(calls) > 1 {
+		c.errorf(method, "expected callback only for %s, got %d calls", s, len(calls))
+	}
+}
+
+func (c checker[T]) checkTransformations(m maybe.Maybe[T]) {
+	c.t.Helper()
+	identity := func(v T) T { return v }
+	c.sameAs("Map", m.Map(identity))
+	c.sameAs("FlatMap", m.FlatMap(func(v T) maybe.Maybe[T] { return maybe.Just(v) }))
+	c.sameAs("Filter", m.Filter(func(T) bool { return true }))
+	c.sameAs("Assert", m.Assert(func(T) bool { return true }, "maybetest"))
+	c.sameAs("maybe.Map", maybe.Map(m, identity))
+
+	rejected := m.Filter(func(T) bool { return false })
+	if c.state != some {
+		c.sameAs("Filter", rejected)
+	} else if !rejected.IsNone() {
+		c.errorf("Filter", "rejecting predicate returned %v", rejected)
+	}
+
+	c.sameAs("FilterErr", m.FilterErr(func(T) (bool, error) { return true, nil }))
+	failed := m.FilterErr(func(T) (bool, error) { return true, errProbe })
+	if c.state != some {
+		c.sameAs("FilterErr", failed)
+	} else if !failed.ErrIs(errProbe) {
+		c.errorf("FilterErr", "failing predicate returned %v", failed)
+	}
+
+	var calls []state
+	result := m.MapIfEmpty(func() (T, error) { calls = append(calls, none); return c.value, nil })
+	c.calledIf("MapIfEmpty", calls, none)
+	if c.state != none {
+		c.sameAs("MapIfEmpty", result)
+	}
+
+	calls = nil
+	result = m.MapIfFailed(func(error) (T, error) { calls = append(calls, failure); return c.value, nil })
+	c.calledIf("MapIfFailed", calls, failure)
+	if c.state != failure {
+		c.sameAs("MapIfFailed", result)
+	}
+
+	calls = nil
+	result = m.MapError(func(err error) error {
+		calls = append(calls, failure)
+		return fmt.Errorf("%w: %w", errProbe, err)
+	})
+	c.calledIf("MapError", calls, failure)
+	if c.state != failure {
+		c.sameAs("MapError", result)
+	} else if !result.ErrIs(errProbe) || !result.ErrIs(c.err) {
+		c.errorf("MapError", "rewritten error not returned, got %v", result)
+	}
+
+	calls = nil
+	result = m.MapSoft(func(v T) (T, error) { calls = append(calls, some); return v, errProbe }, func(error) {})
+	c.calledIf("MapSoft", calls, some)
+	c.sameAs("MapSoft", result)
+}
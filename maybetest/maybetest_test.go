@@ -0,0 +1,179 @@
+package maybetest_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/maybetest"
+)
+
+// recordingTB collects reported violations instead of failing the test.
+type recordingTB struct {
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// cached is a user-defined Some-like state that reuses the built-in behavior.
+type cached[T any] struct {
+	maybe.Maybe[T]
+}
+
+// lyingPredicates reports None from IsNone although it holds a value.
+type lyingPredicates struct {
+	maybe.Maybe[int]
+}
+
+func (lyingPredicates) IsSome() bool { return false }
+func (lyingPredicates) IsNone() bool { return true }
+
+// droppingMap loses the value on Map and leaks calls to Then.
+type droppingMap struct {
+	maybe.Maybe[int]
+}
+
+func (droppingMap) Map(func(int) int) maybe.Maybe[int] { return maybe.Empty[int]() }
+func (d droppingMap) Then(fn func(int)) maybe.Maybe[int] {
+	fn(0)
+	return d
+}
+
+// silentFailure never panics when unwrapped and reports no error from OrError.
+type silentFailure struct {
+	maybe.Maybe[int]
+}
+
+func (silentFailure) OrPanic() int          { return 0 }
+func (silentFailure) OrError() (int, error) { return 0, nil }
+
+func TestCheck(t *testing.T) {
+	t.Run("built-in states conform", func(t *testing.T) {
+		maybetest.Check(t, maybe.Just(42))
+		maybetest.Check(t, maybe.Just([]string{"a"}))
+		maybetest.Check(t, maybe.Empty[int]())
+		maybetest.Check(t, maybe.Failed[int](errors.New("boom")))
+	})
+
+	t.Run("delegating custom state conforms", func(t *testing.T) {
+		maybetest.Check[int](t, cached[int]{maybe.Just(7)})
+	})
+
+	cases := []struct {
+		name string
+		m    maybe.Maybe[int]
+		want []string
+	}{
+		{"inconsistent predicates", lyingPredicates{maybe.Just(1)}, []string{"IsSome/IsNone/IsFailed (Some)"}},
+		{"leaky Then", droppingMap{maybe.Empty[int]()}, []string{"Then (None)"}},
+		{"value-dropping Map", droppingMap{maybe.Just(1)}, []string{"Map (Some): expected Some, got None"}},
+		{"hidden failure", silentFailure{maybe.Failed[int](errors.New("x"))}, []string{"OrError (Failure)", "OrPanic (Failure): did not panic"}},
+		{"absence without error", silentFailure{maybe.Empty[int]()}, []string{"OrError (None): expected an error"}},
+	}
+	for _, c := range cases {
+		t.Run("reports "+c.name, func(t *testing.T) {
+			rec := &recordingTB{}
+			maybetest.Check(rec, c.m)
+			all := strings.Join(rec.errors, "\n")
+			for _, w := range c.want {
+				if !strings.Contains(all, w) {
+					t.Errorf("expected a violation containing %q, got:\n%s", w, all)
+				}
+			}
+		})
+	}
+}
+
+var errOther = errors.New("other")
+
+// liar reports its state through Get but gets every other method wrong.
+type liar struct {
+	v   int
+	ok  bool
+	err error
+}
+
+func (l liar) Get() (int, bool, error)                             { return l.v, l.ok, l.err }
+func (l liar) Map(func(int) int) maybe.Maybe[int]                  { return nil }
+func (l liar) FlatMap(func(int) maybe.Maybe[int]) maybe.Maybe[int] { return nil }
+func (l liar) Filter(func(int) bool) maybe.Maybe[int]              { return maybe.Just(l.v + 1) }
+func (l liar) FilterErr(func(int) (bool, error)) maybe.Maybe[int] {
+	return maybe.Empty[int]()
+}
+func (l liar) Assert(func(int) bool, string) maybe.Maybe[int] {
+	return maybe.Failed[int](errOther)
+}
+func (l liar) Then(func(int)) maybe.Maybe[int]                              { return l }
+func (l liar) TapNone(fn func()) maybe.Maybe[int]                           { fn(); return l }
+func (l liar) TapError(fn func(error)) maybe.Maybe[int]                     { fn(errOther); return l }
+func (l liar) IsSome() bool                                                 { return false }
+func (l liar) IsNone() bool                                                 { return false }
+func (l liar) Exists(func(int) bool) bool                                   { return l.err != nil }
+func (l liar) IsFailed() bool                                               { return false }
+func (l liar) ErrIs(error) bool                                             { return l.err == nil }
+func (l liar) OrError() (int, error)                                        { return l.v + 1, errOther }
+func (l liar) OrElseDefault(int) int                                        { return l.v + 1 }
+func (l liar) OrElseGet(func(error) int) int                                { return l.v + 1 }
+func (l liar) OrPanic() int                                                 { return l.v + 1 }
+func (l liar) ToResult(error) maybe.Result[int]                             { return maybe.Ok(l.v + 1) }
+func (l liar) MustGet() int                                                 { return l.v + 1 }
+func (l liar) GetOrZero() int                                               { return l.v + 1 }
+func (l liar) Expect(string) int                                            { panic(errOther) }
+func (l liar) Accept(maybe.Visitor[int]) maybe.Maybe[int]                   { return l }
+func (l liar) MapIfEmpty(func() (int, error)) maybe.Maybe[int]              { return l }
+func (l liar) MapIfFailed(func(error) (int, error)) maybe.Maybe[int]        { return l }
+func (l liar) MapError(func(error) error) maybe.Maybe[int]                  { return l }
+func (l liar) MapSoft(func(int) (int, error), func(error)) maybe.Maybe[int] { return l }
+func (l liar) MatchThen(someFn func(int), noneFn func(), failureFn func(error)) maybe.Maybe[int] {
+	someFn(l.v + 1)
+	failureFn(errOther)
+	return l
+}
+
+func TestCheck_ReportsEveryMethod(t *testing.T) {
+	cases := []struct {
+		name string
+		m    liar
+		want []string
+	}{
+		{"Some", liar{v: 1, ok: true}, []string{
+			"Map (Some): returned nil", "FlatMap (Some)", "Filter (Some): expected value 1, got 2",
+			"Filter (Some): rejecting predicate", "ErrIs (Some): matched", "OrError (Some)",
+			"OrElseDefault (Some)", "OrElseGet (Some)", "OrPanic (Some)", "MustGet (Some)", "Expect (Some)",
+			"GetOrZero (Some)", "ToResult (Some)",
+			"MatchThen (Some): someFn received 2", "MatchThen (Some): expected one Some branch call",
+			"IsSome/IsNone/IsFailed (Some)", "FilterErr (Some): expected Some, got None",
+			"FilterErr (Some): failing predicate", "Exists (Some): expected callback only for Some",
+			"Exists (Some): reported false", "TapNone (Some)", "TapError (Some)",
+		}},
+		{"None", liar{}, []string{
+			"OrError (None): expected zero value", "ErrIs (None): matched", "ToResult (None)",
+		}},
+		{"Failure", liar{err: errors.New("boom")}, []string{
+			"ErrIs (Failure): does not match", "OrError (Failure): expected error",
+			"Assert (Failure): expected error boom, got other", "Expect (Failure): panic other does not wrap boom",
+			"MatchThen (Failure): failureFn received other", "ToResult (Failure)",
+			"FilterErr (Failure): expected Failure, got None", "MapError (Failure): expected callback only for Failure",
+			"MapError (Failure): rewritten error not returned", "Exists (Failure): reported true",
+			"TapError (Failure): fn received other", "TapNone (Failure)",
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec := &recordingTB{}
+			maybetest.Check[int](rec, c.m)
+			all := strings.Join(rec.errors, "\n")
+			for _, w := range c.want {
+				if !strings.Contains(all, w) {
+					t.Errorf("expected a violation containing %q, got:\n%s", w, all)
+				}
+			}
+		})
+	}
+}
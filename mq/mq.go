@@ -0,0 +1,125 @@
+// Package mq adapts message-queue consumers to lazy sequences of Maybe results, settling
+// each message from the outcome of its handler: Some and None acknowledge it, Failure
+// rejects it for redelivery.
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrStopped is the reason given to Nack for fetched messages that were never handled
+// because the consumer stopped ranging or the context was done.
+var ErrStopped = errors.New("mq: consumer stopped before handling message")
+
+// Hooks settle a message with the queue. A nil hook does nothing.
+type Hooks[M any] struct {
+	// Ack acknowledges a message whose handler returned Some or None.
+	Ack func(M) error
+	// Nack rejects a message, so the queue can redeliver or dead-letter it, with the reason:
+	// the handler's error, or ErrStopped for a message that was never handled.
+	Nack func(M, error) error
+}
+
+// Consume fetches batches of messages and yields the result of handle for each, in order,
+// acknowledging or rejecting the message according to that result before yielding it, so a
+// queue processing loop can be written as a sequence pipeline.
+//
+// Behavior:
+//   - handle returns Some or None: the message is acked and the result yielded
+//   - handle returns Failure or panics: the message is nacked with the error and the Failure yielded
+//   - Ack or Nack fails: the yielded result is a Failure that includes the hook's error
+//   - fetch fails or panics: yields a Failure with the error and stops; a fetch cut short
+//     because ctx is done just stops
+//   - ctx is done or the consumer stops ranging: stops, nacking the rest of the fetched
+//     batch with ErrStopped
+//
+// fetch should block until messages are available or ctx is done; an empty batch is fetched
+// again immediately.
+//
+// Example:
+//
+//	results := mq.Consume(ctx, sub.Pull, processOrder, mq.Hooks[*Message]{
+//	    Ack:  (*Message).Ack,
+//	    Nack: func(m *Message, err error) error { return m.Nack() },
+//	})
+//	for r := range results {
+//	    r.TapError(func(err error) { log.Printf("order rejected: %v", err) })
+//	}
+func Consume[M, R any](
+	ctx context.Context,
+	fetch func(context.Context) ([]M, error),
+	handle func(M) maybe.Maybe[R],
+	hooks Hooks[M],
+) iter.Seq[maybe.Maybe[R]] {
+	return func(yield func(maybe.Maybe[R]) bool) {
+		for ctx.Err() == nil {
+			batch, err := maybe.Try(func() ([]M, error) { return fetch(ctx) }).OrError()
+			if err != nil {
+				if ctx.Err() == nil {
+					yield(maybe.Failed[R](fmt.Errorf("mq: fetch: %w", err)))
+				}
+				return
+			}
+			for i, msg := range batch {
+				if ctx.Err() != nil {
+					hooks.stop(batch[i:])
+					return
+				}
+				if !yield(settle(msg, handle, hooks)) {
+					hooks.stop(batch[i+1:])
+					return
+				}
+			}
+		}
+	}
+}
+
+// settle handles msg and acks or nacks it according to the result.
+func settle[M, R any](msg M, handle func(M) maybe.Maybe[R], hooks Hooks[M]) maybe.Maybe[R] {
+	result := maybe.Do(func() maybe.Maybe[R] { return handle(msg) })
+	if _, _, err := result.Get(); err != nil {
+		if nackErr := hooks.nack(msg, err); nackErr != nil {
+			return maybe.Failed[R](errors.Join(err, nackErr))
+		}
+		return result
+	}
+	if err := hooks.ack(msg); err != nil {
+		return maybe.Failed[R](err)
+	}
+	return result
+}
+
+func (h Hooks[M]) ack(msg M) error {
+	if h.Ack == nil {
+		return nil
+	}
+	_, err := maybe.Try(func() (struct{}, error) { return struct{}{}, h.Ack(msg) }).OrError()
+	if err != nil {
+		return fmt.Errorf("mq: ack: %w", err)
+	}
+	return nil
+}
+
+func (h Hooks[M]) nack(msg M, reason error) error {
+	if h.Nack == nil {
+		return nil
+	}
+	_, err := maybe.Try(func() (struct{}, error) { return struct{}{}, h.Nack(msg, reason) }).OrError()
+	if err != nil {
+		return fmt.Errorf("mq: nack: %w", err)
+	}
+	return nil
+}
+
+// stop nacks messages that were fetched but never handled. Errors are dropped, since
+// there is no result left to report them in.
+func (h Hooks[M]) stop(unhandled []M) {
+	for _, msg := range unhandled {
+		h.nack(msg, ErrStopped)
+	}
+}
@@ -0,0 +1,207 @@
+package mq_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/mq"
+)
+
+// queue is an in-memory broker serving fixed batches and recording how messages were settled.
+type queue struct {
+	batches [][]int
+	acked   []int
+	nacked  map[int]error
+	ackErr  error
+	nackErr error
+}
+
+func newQueue(batches ...[]int) *queue {
+	return &queue{batches: batches, nacked: map[int]error{}}
+}
+
+// fetch serves the next batch, and blocks until ctx is done once there are none left.
+func (q *queue) fetch(ctx context.Context) ([]int, error) {
+	if len(q.batches) == 0 {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	batch := q.batches[0]
+	q.batches = q.batches[1:]
+	return batch, nil
+}
+
+func (q *queue) hooks() mq.Hooks[int] {
+	return mq.Hooks[int]{
+		Ack: func(m int) error {
+			q.acked = append(q.acked, m)
+			return q.ackErr
+		},
+		Nack: func(m int, err error) error {
+			q.nacked[m] = err
+			return q.nackErr
+		},
+	}
+}
+
+var errNegative = errors.New("negative")
+
+// double fails for negative messages and skips zero.
+func double(m int) maybe.Maybe[int] {
+	switch {
+	case m < 0:
+		return maybe.Failed[int](errNegative)
+	case m == 0:
+		return maybe.Empty[int]()
+	}
+	return maybe.Just(m * 2)
+}
+
+func TestConsume(t *testing.T) {
+	t.Run("acks Some and None, nacks Failure, and yields every result", func(t *testing.T) {
+		q := newQueue([]int{1, 0}, []int{-1, 2})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var got []string
+		for r := range mq.Consume(ctx, q.fetch, double, q.hooks()) {
+			got = append(got, fmt.Sprint(r))
+			if len(got) == 4 {
+				cancel()
+			}
+		}
+
+		if want := []string{"Some(2)", "None", "Failure(negative)", "Some(4)"}; !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if !slices.Equal(q.acked, []int{1, 0, 2}) {
+			t.Errorf("expected 1, 0 and 2 to be acked, got %v", q.acked)
+		}
+		if len(q.nacked) != 1 || !errors.Is(q.nacked[-1], errNegative) {
+			t.Errorf("expected -1 to be nacked with the handler's error, got %v", q.nacked)
+		}
+	})
+
+	t.Run("nacks the rest of the batch when the consumer stops", func(t *testing.T) {
+		q := newQueue([]int{1, 2, 3})
+		for range mq.Consume(context.Background(), q.fetch, double, q.hooks()) {
+			break
+		}
+		if !slices.Equal(q.acked, []int{1}) {
+			t.Errorf("expected only 1 to be acked, got %v", q.acked)
+		}
+		if len(q.nacked) != 2 || q.nacked[2] != mq.ErrStopped || q.nacked[3] != mq.ErrStopped {
+			t.Errorf("expected 2 and 3 to be nacked with ErrStopped, got %v", q.nacked)
+		}
+	})
+
+	t.Run("nacks unhandled messages once the context is done", func(t *testing.T) {
+		q := newQueue([]int{1, 2, 3})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var got []maybe.Maybe[int]
+		for r := range mq.Consume(ctx, q.fetch, double, q.hooks()) {
+			got = append(got, r)
+			cancel()
+		}
+		if len(got) != 1 || !slices.Equal(q.acked, []int{1}) {
+			t.Errorf("expected one handled message, got %v", got)
+		}
+		if len(q.nacked) != 2 || q.nacked[2] != mq.ErrStopped || q.nacked[3] != mq.ErrStopped {
+			t.Errorf("expected 2 and 3 to be nacked with ErrStopped, got %v", q.nacked)
+		}
+	})
+
+	t.Run("yields a Failure and stops when fetch fails", func(t *testing.T) {
+		fetchErr := errors.New("broker unreachable")
+		fetch := func(context.Context) ([]int, error) { return nil, fetchErr }
+		got := slices.Collect(mq.Consume(context.Background(), fetch, double, mq.Hooks[int]{}))
+		if len(got) != 1 || !got[0].ErrIs(fetchErr) {
+			t.Errorf("expected one Failure with the fetch error, got %v", got)
+		}
+	})
+
+	t.Run("converts a fetch panic to Failure", func(t *testing.T) {
+		fetch := func(context.Context) ([]int, error) { panic("client closed") }
+		got := slices.Collect(mq.Consume(context.Background(), fetch, double, mq.Hooks[int]{}))
+		if len(got) != 1 || !strings.Contains(fmt.Sprint(got[0]), "client closed") {
+			t.Errorf("expected one Failure with the panic, got %v", got)
+		}
+	})
+
+	t.Run("nacks a message whose handler panics", func(t *testing.T) {
+		q := newQueue([]int{1})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		handle := func(int) maybe.Maybe[int] { panic("handler bug") }
+		for r := range mq.Consume(ctx, q.fetch, handle, q.hooks()) {
+			if !r.IsFailed() {
+				t.Errorf("expected Failure, got %v", r)
+			}
+			cancel()
+		}
+		if err := q.nacked[1]; err == nil || !strings.Contains(err.Error(), "handler bug") {
+			t.Errorf("expected 1 to be nacked with the panic, got %v", err)
+		}
+	})
+
+	t.Run("reports failing hooks in the result", func(t *testing.T) {
+		q := newQueue([]int{1, -1})
+		q.ackErr = errors.New("ack lost")
+		q.nackErr = errors.New("nack lost")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var errs []error
+		for r := range mq.Consume(ctx, q.fetch, double, q.hooks()) {
+			_, _, err := r.Get()
+			errs = append(errs, err)
+			if len(errs) == 2 {
+				cancel()
+			}
+		}
+
+		if len(errs) != 2 || !errors.Is(errs[0], q.ackErr) || !strings.Contains(errs[0].Error(), "mq: ack") {
+			t.Fatalf("expected the ack error first, got %v", errs)
+		}
+		if !errors.Is(errs[1], errNegative) || !errors.Is(errs[1], q.nackErr) {
+			t.Errorf("expected the handler and nack errors joined, got %v", errs[1])
+		}
+	})
+
+	t.Run("converts a hook panic to Failure", func(t *testing.T) {
+		q := newQueue([]int{1})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		hooks := mq.Hooks[int]{Ack: func(int) error { panic("ack bug") }}
+		for r := range mq.Consume(ctx, q.fetch, double, hooks) {
+			if !strings.Contains(fmt.Sprint(r), "ack bug") {
+				t.Errorf("expected Failure with the panic, got %v", r)
+			}
+			cancel()
+		}
+	})
+
+	t.Run("works without hooks and stops at once for a done context", func(t *testing.T) {
+		q := newQueue([]int{-1, 1})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		n := 0
+		for range mq.Consume(ctx, q.fetch, double, mq.Hooks[int]{}) {
+			n++
+			if n == 2 {
+				cancel()
+			}
+		}
+		if n != 2 {
+			t.Errorf("expected 2 results, got %d", n)
+		}
+
+		for range mq.Consume(ctx, q.fetch, double, q.hooks()) {
+			t.Error("expected no results for a done context")
+		}
+	})
+}
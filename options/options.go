@@ -0,0 +1,105 @@
+package options
+
+import (
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Option configures a value of type C in place.
+// Returning an error rejects the configuration.
+//
+// Example:
+//
+//	func WithWorkers(n int) options.Option[PoolConfig] {
+//	    return func(c *PoolConfig) error {
+//	        if n <= 0 {
+//	            return errors.New("workers must be positive")
+//	        }
+//	        c.Workers = n
+//	        return nil
+//	    }
+//	}
+type Option[C any] func(*C) error
+
+// Apply returns a copy of defaults with every option applied in order.
+//
+// Behavior:
+//   - If every option succeeds: returns Just(configured value)
+//   - If an option returns an error: returns Failure with that error (later options not applied)
+//   - If an option panics: returns Failure with the panic converted to an error
+//   - Nil options are skipped
+//
+// Example:
+//
+//	cfg := options.Apply(DefaultPoolConfig, WithWorkers(8), options.Set(queueSize, 100))
+//	// Just(PoolConfig{Workers: 8, QueueSize: 100, ...})
+func Apply[C any](defaults C, opts ...Option[C]) maybe.Maybe[C] {
+	return maybe.Try(func() (C, error) {
+		cfg := defaults
+		for _, opt := range opts {
+			if opt == nil {
+				continue
+			}
+			if err := opt(&cfg); err != nil {
+				var zero C
+				return zero, err
+			}
+		}
+		return cfg, nil
+	})
+}
+
+// Set returns an Option that assigns v to the field selected by field.
+//
+// Example:
+//
+//	timeout := func(c *Config) *time.Duration { return &c.Timeout }
+//	cfg := options.Apply(defaults, options.Set(timeout, 5*time.Second))
+func Set[C, V any](field func(*C) *V, v V) Option[C] {
+	return func(c *C) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+// Override returns an Option driven by a Maybe, so optional overrides (env vars, flags,
+// optional request fields) can be passed straight through without branching.
+//
+// Behavior:
+//   - Some(v): assigns v to the selected field
+//   - None: keeps the current value (usually the default)
+//   - Failure: rejects the configuration with the wrapped error
+//
+// Example:
+//
+//	cfg := options.Apply(defaults,
+//	    options.Override(timeout, parseDuration(os.Getenv("TIMEOUT"))),
+//	)
+func Override[C, V any](field func(*C) *V, m maybe.Maybe[V]) Option[C] {
+	return func(c *C) error {
+		v, ok, err := m.Get()
+		if err != nil {
+			return err
+		}
+		if ok {
+			*field(c) = v
+		}
+		return nil
+	}
+}
+
+// Validate returns an Option that checks the configuration built so far
+// without modifying it. Place it last to validate the final result.
+//
+// Example:
+//
+//	cfg := options.Apply(defaults, opts..., options.Validate(func(c Config) error {
+//	    if c.Timeout <= 0 {
+//	        return errors.New("timeout must be positive")
+//	    }
+//	    return nil
+//	}))
+func Validate[C any](fn func(C) error) Option[C] {
+	return func(c *C) error {
+		return fn(*c)
+	}
+}
@@ -0,0 +1,125 @@
+package options_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/options"
+)
+
+type Config struct {
+	Name    string
+	Workers int
+	Timeout time.Duration
+}
+
+var defaults = Config{Name: "default", Workers: 1, Timeout: time.Second}
+
+func workers(c *Config) *int           { return &c.Workers }
+func timeout(c *Config) *time.Duration { return &c.Timeout }
+
+func TestApply(t *testing.T) {
+	t.Run("returns defaults when no options are given", func(t *testing.T) {
+		cfg, ok, err := options.Apply(defaults).Get()
+		if err != nil || !ok || cfg != defaults {
+			t.Errorf("expected defaults, got %+v, %v, %v", cfg, ok, err)
+		}
+	})
+
+	t.Run("applies options in order", func(t *testing.T) {
+		cfg, _, _ := options.Apply(defaults,
+			options.Set(workers, 4),
+			options.Set(workers, 8),
+		).Get()
+		if cfg.Workers != 8 {
+			t.Errorf("expected 8 workers, got %d", cfg.Workers)
+		}
+	})
+
+	t.Run("does not modify defaults", func(t *testing.T) {
+		d := defaults
+		options.Apply(d, options.Set(workers, 4))
+		if d.Workers != 1 {
+			t.Errorf("defaults should be untouched, got %+v", d)
+		}
+	})
+
+	t.Run("skips nil options", func(t *testing.T) {
+		cfg, ok, _ := options.Apply(defaults, nil, options.Set(workers, 2)).Get()
+		if !ok || cfg.Workers != 2 {
+			t.Errorf("unexpected result %+v", cfg)
+		}
+	})
+
+	t.Run("returns Failure and stops when an option fails", func(t *testing.T) {
+		optErr := errors.New("bad option")
+		called := false
+		result := options.Apply(defaults,
+			func(c *Config) error { return optErr },
+			func(c *Config) error { called = true; return nil },
+		)
+
+		if _, _, err := result.Get(); !errors.Is(err, optErr) {
+			t.Errorf("expected option error, got %v", err)
+		}
+		if called {
+			t.Error("options after a failure should not be applied")
+		}
+	})
+
+	t.Run("converts option panic to Failure", func(t *testing.T) {
+		result := options.Apply(defaults, func(c *Config) error { panic("boom") })
+
+		if _, ok := result.(maybe.Failure[Config]); !ok {
+			t.Fatal("expected Failure when an option panics")
+		}
+	})
+}
+
+func TestOverride(t *testing.T) {
+	t.Run("Some overrides the field", func(t *testing.T) {
+		cfg, _, _ := options.Apply(defaults, options.Override(timeout, maybe.Just(5*time.Second))).Get()
+		if cfg.Timeout != 5*time.Second {
+			t.Errorf("expected 5s, got %v", cfg.Timeout)
+		}
+	})
+
+	t.Run("None keeps the default", func(t *testing.T) {
+		cfg, _, _ := options.Apply(defaults, options.Override(timeout, maybe.Empty[time.Duration]())).Get()
+		if cfg.Timeout != time.Second {
+			t.Errorf("expected default 1s, got %v", cfg.Timeout)
+		}
+	})
+
+	t.Run("Failure rejects the configuration", func(t *testing.T) {
+		parseErr := errors.New("invalid duration")
+		result := options.Apply(defaults, options.Override(timeout, maybe.Failed[time.Duration](parseErr)))
+
+		if _, _, err := result.Get(); !errors.Is(err, parseErr) {
+			t.Errorf("expected parse error, got %v", err)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	positive := options.Validate(func(c Config) error {
+		if c.Workers <= 0 {
+			return errors.New("workers must be positive")
+		}
+		return nil
+	})
+
+	t.Run("passes valid configuration", func(t *testing.T) {
+		if _, ok := options.Apply(defaults, options.Set(workers, 3), positive).(maybe.Some[Config]); !ok {
+			t.Fatal("expected Some for valid configuration")
+		}
+	})
+
+	t.Run("rejects invalid configuration", func(t *testing.T) {
+		if _, ok := options.Apply(defaults, options.Set(workers, 0), positive).(maybe.Failure[Config]); !ok {
+			t.Fatal("expected Failure for invalid configuration")
+		}
+	})
+}
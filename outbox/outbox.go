@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Outbox collects side effects emitted by pipeline steps and defers them until the
+// pipeline's outcome is known. It is safe for concurrent use.
+//
+// Example:
+//
+//	box := outbox.New()
+//	order := maybe.FlatMap(cart, placeOrder).
+//	    Then(func(o Order) { box.Add(func() error { return mailer.SendConfirmation(o) }) })
+//	paid := maybe.FlatMap(order, charge)
+//
+//	result := outbox.Commit(box, paid) // the email is only sent if charge succeeded
+type Outbox struct {
+	mu      sync.Mutex
+	effects []func() error
+}
+
+// New creates an empty Outbox.
+func New() *Outbox {
+	return &Outbox{}
+}
+
+// Add queues effect to be executed by Commit.
+func (o *Outbox) Add(effect func() error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.effects = append(o.effects, effect)
+}
+
+// Len returns the number of queued effects.
+func (o *Outbox) Len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.effects)
+}
+
+// Discard drops every queued effect without executing it.
+func (o *Outbox) Discard() {
+	o.take()
+}
+
+func (o *Outbox) take() []func() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	effects := o.effects
+	o.effects = nil
+	return effects
+}
+
+// Commit executes the queued effects if m is Some and discards them otherwise.
+// Either way the Outbox is empty afterwards.
+//
+// Behavior:
+//   - If m is None or Failure: discards the effects and returns m
+//   - If m is Some: executes every effect in the order added and returns m
+//   - If any effect fails or panics: the remaining effects still run, and Commit
+//     returns Failure joining the effect errors
+//
+// Example:
+//
+//	result := outbox.Commit(box, pipelineResult)
+func Commit[T any](o *Outbox, m maybe.Maybe[T]) maybe.Maybe[T] {
+	effects := o.take()
+	if _, ok, err := m.Get(); !ok || err != nil {
+		return m
+	}
+
+	var errs []error
+	for i, effect := range effects {
+		_, err := maybe.Try(func() (struct{}, error) {
+			return struct{}{}, effect()
+		}).OrError()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("outbox effect %d: %w", i, err))
+		}
+	}
+	if len(errs) > 0 {
+		return maybe.Failed[T](errors.Join(errs...))
+	}
+	return m
+}
+
+// Run creates an Outbox, passes it to fn, and commits it against fn's result,
+// giving fn all-or-nothing semantics for the effects it queues.
+// A panic in fn becomes a Failure and discards the effects.
+//
+// Example:
+//
+//	result := outbox.Run(func(box *outbox.Outbox) maybe.Maybe[User] {
+//	    return maybe.FlatMap(signup, func(u User) maybe.Maybe[User] {
+//	        box.Add(func() error { return events.Publish(UserCreated{u.ID}) })
+//	        return provision(u)
+//	    })
+//	})
+func Run[T any](fn func(o *Outbox) maybe.Maybe[T]) maybe.Maybe[T] {
+	o := New()
+	return Commit(o, maybe.Do(func() maybe.Maybe[T] { return fn(o) }))
+}
@@ -0,0 +1,137 @@
+package outbox_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/outbox"
+)
+
+func recordingEffect(log *[]string, name string) func() error {
+	return func() error {
+		*log = append(*log, name)
+		return nil
+	}
+}
+
+// customSome is a user-defined Maybe in the Some state that is not the built-in Some type.
+type customSome struct{ maybe.Some[int] }
+
+func TestCommit(t *testing.T) {
+	t.Run("executes effects in order on Some", func(t *testing.T) {
+		var log []string
+		box := outbox.New()
+		box.Add(recordingEffect(&log, "email"))
+		box.Add(recordingEffect(&log, "event"))
+
+		result := outbox.Commit[int](box, maybe.Just(1))
+		if v, _, _ := result.Get(); v != 1 {
+			t.Errorf("expected original Some, got %v", result)
+		}
+		if strings.Join(log, ",") != "email,event" {
+			t.Errorf("expected effects in order, got %v", log)
+		}
+		if box.Len() != 0 {
+			t.Errorf("expected empty outbox after commit, got %d", box.Len())
+		}
+	})
+
+	t.Run("executes effects for a custom Some implementation", func(t *testing.T) {
+		var log []string
+		box := outbox.New()
+		box.Add(recordingEffect(&log, "email"))
+
+		outbox.Commit[int](box, customSome{maybe.Just(1)})
+		if len(log) != 1 {
+			t.Errorf("expected effect to run, got %v", log)
+		}
+	})
+
+	t.Run("discards effects on None and Failure", func(t *testing.T) {
+		var log []string
+		err := errors.New("charge declined")
+		for _, m := range []maybe.Maybe[int]{maybe.Empty[int](), maybe.Failed[int](err)} {
+			box := outbox.New()
+			box.Add(recordingEffect(&log, "email"))
+
+			if result := outbox.Commit(box, m); result != m {
+				t.Errorf("expected original result, got %v", result)
+			}
+			if box.Len() != 0 {
+				t.Error("expected effects to be discarded")
+			}
+		}
+		if len(log) != 0 {
+			t.Errorf("expected no effects to run, got %v", log)
+		}
+	})
+
+	t.Run("runs every effect and joins failures", func(t *testing.T) {
+		var log []string
+		sendErr := errors.New("smtp down")
+		box := outbox.New()
+		box.Add(func() error { return sendErr })
+		box.Add(func() error { panic("broker gone") })
+		box.Add(recordingEffect(&log, "audit"))
+
+		_, _, err := outbox.Commit[string](box, maybe.Just("ok")).Get()
+		if !errors.Is(err, sendErr) || !strings.Contains(err.Error(), "outbox effect 1") {
+			t.Errorf("expected joined effect errors, got %v", err)
+		}
+		if len(log) != 1 {
+			t.Errorf("expected later effects to still run, got %v", log)
+		}
+	})
+}
+
+func TestOutbox_Discard(t *testing.T) {
+	var log []string
+	box := outbox.New()
+	box.Add(recordingEffect(&log, "email"))
+	box.Discard()
+
+	outbox.Commit[int](box, maybe.Just(1))
+	if len(log) != 0 || box.Len() != 0 {
+		t.Errorf("expected discarded effects not to run, got %v", log)
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("commits effects queued by a successful pipeline", func(t *testing.T) {
+		var log []string
+		result := outbox.Run(func(box *outbox.Outbox) maybe.Maybe[int] {
+			return maybe.Just(1).Then(func(int) { box.Add(recordingEffect(&log, "notify")) })
+		})
+
+		if _, ok := result.(maybe.Some[int]); !ok || len(log) != 1 {
+			t.Errorf("expected effect to run, got %v and %v", result, log)
+		}
+	})
+
+	t.Run("discards effects when the pipeline fails later", func(t *testing.T) {
+		var log []string
+		result := outbox.Run(func(box *outbox.Outbox) maybe.Maybe[int] {
+			return maybe.Just(1).
+				Then(func(int) { box.Add(recordingEffect(&log, "notify")) }).
+				FlatMap(func(int) maybe.Maybe[int] { return maybe.Failed[int](errors.New("later step")) })
+		})
+
+		if _, ok := result.(maybe.Failure[int]); !ok || len(log) != 0 {
+			t.Errorf("expected Failure and no effects, got %v and %v", result, log)
+		}
+	})
+
+	t.Run("treats panics as Failure", func(t *testing.T) {
+		var log []string
+		result := outbox.Run(func(box *outbox.Outbox) maybe.Maybe[int] {
+			box.Add(recordingEffect(&log, "notify"))
+			panic("pipeline bug")
+		})
+
+		if _, ok := result.(maybe.Failure[int]); !ok || len(log) != 0 {
+			t.Errorf("expected Failure and no effects, got %v and %v", result, log)
+		}
+	})
+}
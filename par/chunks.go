@@ -0,0 +1,120 @@
+package par
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ChunkFailure records why the chunk starting at Offset in the input failed.
+type ChunkFailure struct {
+	Offset int
+	Err    error
+}
+
+// ChunksError is the aggregate Failure of TraverseChunks. It lists every failed chunk by
+// its offset into the input, so callers can retry exactly those items.
+type ChunksError struct {
+	Failures []ChunkFailure
+}
+
+// Error lists each failed chunk offset with its error.
+func (e *ChunksError) Error() string {
+	parts := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		parts[i] = fmt.Sprintf("offset %d: %v", f.Offset, f.Err)
+	}
+	return fmt.Sprintf("par: %d chunk(s) failed: %s", len(e.Failures), strings.Join(parts, "; "))
+}
+
+// Unwrap returns the chunk errors, so errors.Is and errors.As see through the aggregate.
+func (e *ChunksError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// Offsets returns the input offsets of the failed chunks in ascending order.
+func (e *ChunksError) Offsets() []int {
+	offsets := make([]int, len(e.Failures))
+	for i, f := range e.Failures {
+		offsets[i] = f.Offset
+	}
+	return offsets
+}
+
+// TraverseChunks splits items into chunks of chunkSize and runs fn on the chunks using up to
+// workers goroutines. Each call to fn handles one chunk, which makes it the natural place for
+// a per-chunk transaction.
+//
+// Behavior:
+//   - If every chunk succeeds: returns Just(results of all chunks concatenated in input order)
+//   - If any chunk fails or panics: the other chunks still run, and the result is a
+//     Failure(*ChunksError) listing every failed chunk offset
+//   - Chunks not yet started when ctx is done fail with ctx.Err()
+//   - If chunkSize < 1: returns Failure without calling fn; workers < 1 is treated as 1
+//
+// Example:
+//
+//	result := par.TraverseChunks(ctx, rows, 500, 4, func(ctx context.Context, chunk []Row) ([]ID, error) {
+//	    return sqlfp.WithTx(ctx, db, func(tx *sql.Tx) ([]ID, error) {
+//	        return insertAll(ctx, tx, chunk)
+//	    }).OrError()
+//	})
+//
+//	_, err := result.OrError()
+//	var chunksErr *par.ChunksError
+//	if errors.As(err, &chunksErr) {
+//	    retry(chunksErr.Offsets())
+//	}
+func TraverseChunks[A, B any](ctx context.Context, items []A, chunkSize, workers int, fn func(ctx context.Context, chunk []A) ([]B, error)) maybe.Maybe[[]B] {
+	if chunkSize < 1 {
+		return maybe.Failed[[]B](fmt.Errorf("par: chunk size must be positive, got %d", chunkSize))
+	}
+	workers = max(workers, 1)
+
+	offsets := make(chan int)
+	results := make([]maybe.Maybe[[]B], (len(items)+chunkSize-1)/chunkSize)
+
+	var wg sync.WaitGroup
+	for range min(workers, len(results)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for offset := range offsets {
+				chunk := items[offset:min(offset+chunkSize, len(items))]
+				results[offset/chunkSize] = maybe.Try(func() ([]B, error) {
+					if err := ctx.Err(); err != nil {
+						return nil, err
+					}
+					return fn(ctx, chunk)
+				})
+			}
+		}()
+	}
+	for offset := 0; offset < len(items); offset += chunkSize {
+		offsets <- offset
+	}
+	close(offsets)
+	wg.Wait()
+
+	out := []B{}
+	var failures []ChunkFailure
+	for i, r := range results {
+		values, err := r.OrError()
+		if err != nil {
+			failures = append(failures, ChunkFailure{Offset: i * chunkSize, Err: err})
+			continue
+		}
+		out = append(out, values...)
+	}
+	if len(failures) > 0 {
+		return maybe.Failed[[]B](&ChunksError{Failures: failures})
+	}
+	return maybe.Just(out)
+}
@@ -0,0 +1,137 @@
+package par_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/par"
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+func double(ctx context.Context, chunk []int) ([]int, error) {
+	out := make([]int, len(chunk))
+	for i, v := range chunk {
+		out[i] = v * 2
+	}
+	return out, nil
+}
+
+func TestTraverseChunks(t *testing.T) {
+	t.Run("returns results in input order", func(t *testing.T) {
+		items := seq.Range(0, 10, 1)
+
+		got, err := par.TraverseChunks(context.Background(), items, 3, 4, double).OrError()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := seq.Range(0, 20, 2); !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("passes chunks of the requested size", func(t *testing.T) {
+		var mu sync.Mutex
+		var sizes []int
+		_ = par.TraverseChunks(context.Background(), seq.Range(0, 7, 1), 3, 1, func(ctx context.Context, chunk []int) ([]int, error) {
+			mu.Lock()
+			sizes = append(sizes, len(chunk))
+			mu.Unlock()
+			return chunk, nil
+		})
+
+		if !slices.Equal(sizes, []int{3, 3, 1}) {
+			t.Errorf("expected chunk sizes [3 3 1], got %v", sizes)
+		}
+	})
+
+	t.Run("limits concurrency to workers", func(t *testing.T) {
+		var running, peak atomic.Int32
+		_ = par.TraverseChunks(context.Background(), seq.Range(0, 20, 1), 2, 3, func(ctx context.Context, chunk []int) ([]int, error) {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return chunk, nil
+		})
+
+		if p := peak.Load(); p > 3 || p < 2 {
+			t.Errorf("expected peak concurrency between 2 and 3, got %d", p)
+		}
+	})
+
+	t.Run("reports offsets of every failed chunk", func(t *testing.T) {
+		chunkErr := errors.New("deadlock")
+		var calls atomic.Int32
+		result := par.TraverseChunks(context.Background(), seq.Range(0, 10, 1), 2, 2, func(ctx context.Context, chunk []int) ([]int, error) {
+			calls.Add(1)
+			switch chunk[0] {
+			case 2:
+				return nil, chunkErr
+			case 6:
+				panic("driver crashed")
+			}
+			return chunk, nil
+		})
+
+		_, err := result.OrError()
+		var chunksErr *par.ChunksError
+		if !errors.As(err, &chunksErr) {
+			t.Fatalf("expected ChunksError, got %v", err)
+		}
+		if !slices.Equal(chunksErr.Offsets(), []int{2, 6}) {
+			t.Errorf("expected offsets [2 6], got %v", chunksErr.Offsets())
+		}
+		if !errors.Is(err, chunkErr) {
+			t.Error("expected chunk error to be reachable with errors.Is")
+		}
+		if calls.Load() != 5 {
+			t.Errorf("expected all 5 chunks to run, got %d", calls.Load())
+		}
+		if !strings.HasPrefix(err.Error(), "par: 2 chunk(s) failed: offset 2: deadlock; offset 6: ") {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	})
+
+	t.Run("fails chunks when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := par.TraverseChunks(ctx, seq.Range(0, 4, 1), 2, 2, double).OrError()
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("returns empty slice for empty input", func(t *testing.T) {
+		got, err := par.TraverseChunks(context.Background(), nil, 5, 2, double).OrError()
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("expected empty slice, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("rejects non-positive chunk size", func(t *testing.T) {
+		result := par.TraverseChunks(context.Background(), []int{1}, 0, 1, double)
+		if _, ok := result.(maybe.Failure[[]int]); !ok {
+			t.Error("expected Failure")
+		}
+	})
+
+	t.Run("treats non-positive workers as one", func(t *testing.T) {
+		got, _ := par.TraverseChunks(context.Background(), []int{1, 2, 3}, 2, 0, double).OrError()
+		if !slices.Equal(got, []int{2, 4, 6}) {
+			t.Errorf("expected [2 4 6], got %v", got)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/lonelywolflee/lw-project-fp-go/internal/reflectx"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Patch is a set of field changes keyed by field name.
+// Only fields holding a Some take part in a Patch, which makes it suitable for
+// REST PATCH semantics, audit logs, and minimal event payloads alike.
+//
+// The zero value is an empty Patch.
+type Patch struct {
+	fields map[string]reflect.Value
+}
+
+// Len returns the number of changed fields.
+func (p Patch) Len() int {
+	return len(p.fields)
+}
+
+// Fields returns the changed field names in sorted order.
+//
+// Example:
+//
+//	p.Fields() // []string{"Email", "Name"}
+func (p Patch) Fields() []string {
+	names := make([]string, 0, len(p.fields))
+	for name := range p.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Values returns the unwrapped new value of every changed field, keyed by field name.
+// This is the shape to use for audit logs or event payloads.
+//
+// Example:
+//
+//	p.Values() // map[string]any{"Name": "Bob"}
+func (p Patch) Values() map[string]any {
+	values := make(map[string]any, len(p.fields))
+	for name, field := range p.fields {
+		v, _, _, _ := reflectx.Unwrap(field)
+		values[name] = v.Interface()
+	}
+	return values
+}
+
+// Diff compares two structs of the same type and returns a Patch containing every
+// Maybe field that is Some in new and differs from old.
+//
+// Behavior:
+//   - Maybe field that is Some in new and not an equal Some in old: included
+//   - Maybe field that is None in new: ignored, even if old is Some
+//   - Maybe field that is Failure in new: Diff returns Failure with the field's error
+//   - Non-Maybe fields and unexported fields: ignored
+//   - Non-struct arguments: Diff returns Failure
+//
+// Values are compared with reflect.DeepEqual.
+//
+// Example:
+//
+//	old := UserForm{Name: maybe.Just("Alice"), Email: maybe.Just("a@example.com")}
+//	new := UserForm{Name: maybe.Just("Bob"), Email: maybe.Just("a@example.com")}
+//	p := patch.Diff(old, new) // Just(Patch{Name: "Bob"})
+func Diff[S any](old, new S) maybe.Maybe[Patch] {
+	return maybe.Do(func() maybe.Maybe[Patch] {
+		ov := reflect.ValueOf(old)
+		nv := reflect.ValueOf(new)
+		if nv.Kind() != reflect.Struct {
+			return maybe.Failed[Patch](errors.New("patch: arguments must be structs"))
+		}
+
+		p := Patch{fields: map[string]reflect.Value{}}
+		for i := 0; i < nv.NumField(); i++ {
+			field := nv.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			newValue, ok, err, isMaybe := reflectx.Unwrap(nv.Field(i))
+			if !isMaybe {
+				continue
+			}
+			if err != nil {
+				return maybe.Failed[Patch](fmt.Errorf("patch: field %s: %w", field.Name, err))
+			}
+			if !ok {
+				continue
+			}
+			oldValue, oldOk, _, _ := reflectx.Unwrap(ov.Field(i))
+			if oldOk && reflect.DeepEqual(oldValue.Interface(), newValue.Interface()) {
+				continue
+			}
+			p.fields[field.Name] = nv.Field(i)
+		}
+		return maybe.Just(p)
+	})
+}
+
+// Apply returns a copy of entity with every field in the Patch set, matching fields by name.
+// A field that is a Maybe in the entity receives the Some itself; a plain field receives
+// the unwrapped value. Patch fields without a counterpart in the entity are ignored.
+//
+// Behavior:
+//   - Returns Just(updated entity) when every change can be applied
+//   - Returns Failure if entity is not a struct or a field type is incompatible
+//
+// Example:
+//
+//	updated := patch.Apply(user, p) // Just(User{Name: "Bob", ...})
+func Apply[E any](entity E, p Patch) maybe.Maybe[E] {
+	return maybe.Do(func() maybe.Maybe[E] {
+		out := reflect.ValueOf(&entity).Elem()
+		if out.Kind() != reflect.Struct {
+			return maybe.Failed[E](errors.New("patch: entity must be a struct"))
+		}
+
+		for _, name := range p.Fields() {
+			target := out.FieldByName(name)
+			if !target.IsValid() || !target.CanSet() {
+				continue
+			}
+			if err := reflectx.Assign(target, p.fields[name]); err != nil {
+				return maybe.Failed[E](fmt.Errorf("patch: field %s: %w", name, err))
+			}
+		}
+		return maybe.Just(entity)
+	})
+}
@@ -0,0 +1,132 @@
+package patch_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/patch"
+)
+
+type UserForm struct {
+	Name    maybe.Maybe[string]
+	Email   maybe.Maybe[string]
+	Age     maybe.Maybe[int]
+	Tags    maybe.Maybe[[]string]
+	Comment string
+	secret  maybe.Maybe[string]
+}
+
+type User struct {
+	Name  string
+	Email maybe.Maybe[string]
+	Age   int
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("includes changed Some fields only", func(t *testing.T) {
+		old := UserForm{Name: maybe.Just("Alice"), Email: maybe.Just("a@example.com"), Age: maybe.Just(30)}
+		new := UserForm{Name: maybe.Just("Bob"), Email: maybe.Just("a@example.com"), Age: maybe.Empty[int](), Comment: "x"}
+
+		p, ok, err := patch.Diff(old, new).Get()
+		if err != nil || !ok {
+			t.Fatalf("expected Some, got ok=%v err=%v", ok, err)
+		}
+		if !reflect.DeepEqual(p.Fields(), []string{"Name"}) {
+			t.Errorf("expected [Name], got %v", p.Fields())
+		}
+		if !reflect.DeepEqual(p.Values(), map[string]any{"Name": "Bob"}) {
+			t.Errorf("unexpected values %v", p.Values())
+		}
+	})
+
+	t.Run("includes Some fields that were None or nil before", func(t *testing.T) {
+		old := UserForm{Age: maybe.Empty[int]()}
+		new := UserForm{Name: maybe.Just("Bob"), Age: maybe.Just(1)}
+
+		p, _, _ := patch.Diff(old, new).Get()
+		if !reflect.DeepEqual(p.Fields(), []string{"Age", "Name"}) {
+			t.Errorf("expected [Age Name], got %v", p.Fields())
+		}
+	})
+
+	t.Run("compares values deeply", func(t *testing.T) {
+		old := UserForm{Tags: maybe.Just([]string{"a", "b"})}
+		same := UserForm{Tags: maybe.Just([]string{"a", "b"})}
+		changed := UserForm{Tags: maybe.Just([]string{"a"})}
+
+		if p, _, _ := patch.Diff(old, same).Get(); p.Len() != 0 {
+			t.Errorf("expected empty patch, got %v", p.Fields())
+		}
+		if p, _, _ := patch.Diff(old, changed).Get(); p.Len() != 1 {
+			t.Errorf("expected one change, got %v", p.Fields())
+		}
+	})
+
+	t.Run("returns Failure for Failure fields", func(t *testing.T) {
+		fieldErr := errors.New("invalid")
+		_, _, err := patch.Diff(UserForm{}, UserForm{Email: maybe.Failed[string](fieldErr)}).Get()
+		if !errors.Is(err, fieldErr) {
+			t.Errorf("expected field error, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure for non-struct arguments", func(t *testing.T) {
+		if _, ok := patch.Diff(1, 2).(maybe.Failure[patch.Patch]); !ok {
+			t.Fatal("expected Failure for non-struct arguments")
+		}
+	})
+}
+
+func TestApply(t *testing.T) {
+	t.Run("sets plain and Maybe fields", func(t *testing.T) {
+		p, _, _ := patch.Diff(UserForm{}, UserForm{
+			Name:  maybe.Just("Bob"),
+			Email: maybe.Just("b@example.com"),
+			Age:   maybe.Just(42),
+		}).Get()
+
+		user, ok, err := patch.Apply(User{Name: "Alice", Age: 30}, p).Get()
+		if err != nil || !ok {
+			t.Fatalf("expected Some, got ok=%v err=%v", ok, err)
+		}
+		email, _, _ := user.Email.Get()
+		if user.Name != "Bob" || email != "b@example.com" || user.Age != 42 {
+			t.Errorf("unexpected result %+v", user)
+		}
+	})
+
+	t.Run("ignores fields missing from the entity", func(t *testing.T) {
+		p, _, _ := patch.Diff(UserForm{}, UserForm{Tags: maybe.Just([]string{"x"})}).Get()
+
+		user, ok, err := patch.Apply(User{Name: "Alice"}, p).Get()
+		if err != nil || !ok || user.Name != "Alice" {
+			t.Errorf("unexpected result %+v, ok=%v, err=%v", user, ok, err)
+		}
+	})
+
+	t.Run("empty patch leaves entity unchanged", func(t *testing.T) {
+		user, _, _ := patch.Apply(User{Name: "Alice"}, patch.Patch{}).Get()
+		if user.Name != "Alice" {
+			t.Errorf("unexpected result %+v", user)
+		}
+	})
+
+	t.Run("returns Failure for incompatible field types", func(t *testing.T) {
+		type Other struct {
+			Age string
+		}
+		p, _, _ := patch.Diff(UserForm{}, UserForm{Age: maybe.Just(1)}).Get()
+
+		if _, ok := patch.Apply(Other{}, p).(maybe.Failure[Other]); !ok {
+			t.Fatal("expected Failure for incompatible field type")
+		}
+	})
+
+	t.Run("returns Failure for non-struct entity", func(t *testing.T) {
+		if _, ok := patch.Apply(42, patch.Patch{}).(maybe.Failure[int]); !ok {
+			t.Fatal("expected Failure for non-struct entity")
+		}
+	})
+}
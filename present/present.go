@@ -0,0 +1,192 @@
+package present
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/lonelywolflee/lw-project-fp-go/internal/reflectx"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// MissingError is the error carried by the Failure returned by Require and Together
+// when fields are not present.
+type MissingError struct {
+	Fields []string
+}
+
+func (e *MissingError) Error() string {
+	return "present: missing fields: " + strings.Join(e.Fields, ", ")
+}
+
+// ConflictError is the error carried by the Failure returned by MutuallyExclusive
+// when more than one of the fields is present.
+type ConflictError struct {
+	Fields []string
+}
+
+func (e *ConflictError) Error() string {
+	return "present: mutually exclusive fields set: " + strings.Join(e.Fields, ", ")
+}
+
+// Fields reports, for every exported Maybe field of the struct v (or the struct v points to),
+// whether that field is present, that is, holds a Some. None and Failure fields are reported
+// as not present; other fields are left out. A v that is not a struct yields an empty map.
+//
+// Example:
+//
+//	type UpdateRequest struct {
+//	    Name  maybe.Maybe[string]
+//	    Email maybe.Maybe[string]
+//	    ID    int
+//	}
+//
+//	present.Fields(UpdateRequest{Name: maybe.Just("Bob"), Email: maybe.Empty[string]()})
+//	// map[string]bool{"Name": true, "Email": false}
+func Fields(v any) map[string]bool {
+	fields := map[string]bool{}
+	rv, ok := structValue(v)
+	if !ok {
+		return fields
+	}
+	for i := 0; i < rv.NumField(); i++ {
+		if !rv.Type().Field(i).IsExported() {
+			continue
+		}
+		if _, ok, _, isMaybe := reflectx.Unwrap(rv.Field(i)); isMaybe {
+			fields[rv.Type().Field(i).Name] = ok
+		}
+	}
+	return fields
+}
+
+// Require checks that every named Maybe field of v is present.
+//
+// Behavior:
+//   - If every named field holds a Some: returns Just(struct{}{})
+//   - If a named field holds a Failure: returns Failure with that field's error
+//   - If v is not a struct, or a name is not an exported Maybe field of v: returns Failure
+//   - Otherwise: returns Failure with a *MissingError listing the absent fields in the given order
+//
+// Example:
+//
+//	present.Require(req, "Street", "City", "Zip")
+//	// Failed(*MissingError{Fields: ["Zip"]}) when only Street and City are set
+func Require(v any, names ...string) maybe.Maybe[struct{}] {
+	return maybe.Do(func() maybe.Maybe[struct{}] {
+		missing, _, err := inspect(v, names)
+		if err != nil {
+			return maybe.Failed[struct{}](err)
+		}
+		if len(missing) > 0 {
+			return maybe.Failed[struct{}](&MissingError{Fields: missing})
+		}
+		return maybe.Just(struct{}{})
+	})
+}
+
+// Together checks that the named Maybe fields of v are either all present or all absent,
+// for optional fields that only make sense as a group.
+//
+// Behavior:
+//   - If every named field holds a Some, or none does: returns Just(struct{}{})
+//   - If only some are present: returns Failure with a *MissingError listing the absent ones
+//   - Failures, non-struct values and unknown names are reported as with Require
+//
+// Example:
+//
+//	present.Together(req, "Latitude", "Longitude") // both or neither
+func Together(v any, names ...string) maybe.Maybe[struct{}] {
+	return maybe.Do(func() maybe.Maybe[struct{}] {
+		missing, found, err := inspect(v, names)
+		if err != nil {
+			return maybe.Failed[struct{}](err)
+		}
+		if found > 0 && len(missing) > 0 {
+			return maybe.Failed[struct{}](&MissingError{Fields: missing})
+		}
+		return maybe.Just(struct{}{})
+	})
+}
+
+// RequiredIf checks that the named Maybe fields of v are present when cond holds, for rules
+// such as "a company name is required for business accounts". When cond is false the fields
+// are not inspected at all.
+//
+// Behavior:
+//   - If cond is false: returns Just(struct{}{})
+//   - If cond is true: behaves like Require
+//
+// Example:
+//
+//	present.RequiredIf(form, form.AccountType == "business", "CompanyName", "VATNumber")
+func RequiredIf(v any, cond bool, names ...string) maybe.Maybe[struct{}] {
+	if !cond {
+		return maybe.Just(struct{}{})
+	}
+	return Require(v, names...)
+}
+
+// MutuallyExclusive checks that at most one of the named Maybe fields of v is present.
+// Combine it with Require-style checks for "exactly one of" rules.
+//
+// Behavior:
+//   - If at most one named field holds a Some: returns Just(struct{}{})
+//   - If several do: returns Failure with a *ConflictError listing the present ones
+//   - Failures, non-struct values and unknown names are reported as with Require
+//
+// Example:
+//
+//	// either email or phone, not both, and at least one of them
+//	contact := present.MutuallyExclusive(form, "Email", "Phone").
+//	    Filter(func(struct{}) bool { return form.Email.IsSome() || form.Phone.IsSome() })
+func MutuallyExclusive(v any, names ...string) maybe.Maybe[struct{}] {
+	return maybe.Do(func() maybe.Maybe[struct{}] {
+		missing, _, err := inspect(v, names)
+		if err != nil {
+			return maybe.Failed[struct{}](err)
+		}
+		set := slices.DeleteFunc(slices.Clone(names), func(name string) bool {
+			return slices.Contains(missing, name)
+		})
+		if len(set) > 1 {
+			return maybe.Failed[struct{}](&ConflictError{Fields: set})
+		}
+		return maybe.Just(struct{}{})
+	})
+}
+
+// inspect returns the named fields that are absent and the number that are present.
+func inspect(v any, names []string) (missing []string, found int, err error) {
+	rv, ok := structValue(v)
+	if !ok {
+		return nil, 0, fmt.Errorf("present: expected a struct, got %T", v)
+	}
+	for _, name := range names {
+		field, ok := rv.Type().FieldByName(name)
+		if !ok || !field.IsExported() {
+			return nil, 0, fmt.Errorf("present: no exported field %s in %s", name, rv.Type())
+		}
+		_, ok, fieldErr, isMaybe := reflectx.Unwrap(rv.FieldByIndex(field.Index))
+		switch {
+		case !isMaybe:
+			return nil, 0, fmt.Errorf("present: field %s is not a Maybe", name)
+		case fieldErr != nil:
+			return nil, 0, fmt.Errorf("present: field %s: %w", name, fieldErr)
+		case ok:
+			found++
+		default:
+			missing = append(missing, name)
+		}
+	}
+	return missing, found, nil
+}
+
+func structValue(v any) (reflect.Value, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	return rv, rv.Kind() == reflect.Struct
+}
@@ -0,0 +1,166 @@
+package present_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/present"
+)
+
+type address struct {
+	Street    maybe.Maybe[string]
+	City      maybe.Maybe[string]
+	Zip       maybe.Maybe[string]
+	Latitude  maybe.Maybe[float64]
+	Longitude maybe.Maybe[float64]
+	ID        int
+	note      maybe.Maybe[string]
+}
+
+func missingFields(t *testing.T, m maybe.Maybe[struct{}]) []string {
+	t.Helper()
+	_, _, err := m.Get()
+	var missing *present.MissingError
+	if !errors.As(err, &missing) {
+		t.Fatalf("expected *MissingError, got %v", err)
+	}
+	return missing.Fields
+}
+
+func TestFields(t *testing.T) {
+	t.Run("reports presence of exported Maybe fields", func(t *testing.T) {
+		a := address{Street: maybe.Just("Main St"), City: maybe.Empty[string](), Zip: maybe.Failed[string](errors.New("bad zip"))}
+		got := present.Fields(&a)
+		want := map[string]bool{"Street": true, "City": false, "Zip": false, "Latitude": false, "Longitude": false}
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("%s: expected %v, got %v", k, v, got[k])
+			}
+		}
+	})
+
+	t.Run("returns empty map for non-struct", func(t *testing.T) {
+		if got := present.Fields(42); len(got) != 0 {
+			t.Errorf("expected empty map, got %v", got)
+		}
+	})
+}
+
+func TestRequire(t *testing.T) {
+	t.Run("succeeds when all named fields are Some", func(t *testing.T) {
+		a := address{Street: maybe.Just("Main St"), City: maybe.Just("Springfield")}
+		if !present.Require(a, "Street", "City").IsSome() {
+			t.Error("expected Some")
+		}
+	})
+
+	t.Run("lists missing fields in order", func(t *testing.T) {
+		a := address{City: maybe.Just("Springfield"), Zip: maybe.Empty[string]()}
+		m := present.Require(a, "Zip", "City", "Street")
+		if got := missingFields(t, m); !slices.Equal(got, []string{"Zip", "Street"}) {
+			t.Errorf("unexpected missing fields %v", got)
+		}
+		if _, _, err := m.Get(); err.Error() != "present: missing fields: Zip, Street" {
+			t.Errorf("unexpected message %q", err)
+		}
+	})
+
+	t.Run("propagates field Failure", func(t *testing.T) {
+		errZip := errors.New("bad zip")
+		if !present.Require(address{Zip: maybe.Failed[string](errZip)}, "Zip").ErrIs(errZip) {
+			t.Error("expected field error")
+		}
+	})
+
+	t.Run("rejects unknown, unexported and non-Maybe fields", func(t *testing.T) {
+		for _, name := range []string{"Country", "note", "ID"} {
+			_, _, err := present.Require(address{}, name).Get()
+			if err == nil || !strings.Contains(err.Error(), name) {
+				t.Errorf("%s: expected error naming the field, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("rejects non-struct", func(t *testing.T) {
+		if !present.Require("nope", "A").IsFailed() {
+			t.Error("expected Failure")
+		}
+	})
+}
+
+func TestTogether(t *testing.T) {
+	t.Run("accepts all or none present", func(t *testing.T) {
+		both := address{Latitude: maybe.Just(1.5), Longitude: maybe.Just(2.5)}
+		if !present.Together(both, "Latitude", "Longitude").IsSome() {
+			t.Error("expected Some when both present")
+		}
+		if !present.Together(address{}, "Latitude", "Longitude").IsSome() {
+			t.Error("expected Some when neither present")
+		}
+	})
+
+	t.Run("reports the absent half of a partial group", func(t *testing.T) {
+		m := present.Together(address{Latitude: maybe.Just(1.5)}, "Latitude", "Longitude")
+		if got := missingFields(t, m); !slices.Equal(got, []string{"Longitude"}) {
+			t.Errorf("unexpected missing fields %v", got)
+		}
+	})
+
+	t.Run("reports errors as Require does", func(t *testing.T) {
+		if !present.Together(address{}, "Country").IsFailed() {
+			t.Error("expected Failure")
+		}
+	})
+}
+
+func TestRequiredIf(t *testing.T) {
+	t.Run("skips check when condition is false", func(t *testing.T) {
+		if !present.RequiredIf(address{}, false, "Zip", "Unknown").IsSome() {
+			t.Error("expected Some when condition is false")
+		}
+	})
+
+	t.Run("requires fields when condition is true", func(t *testing.T) {
+		m := present.RequiredIf(address{Street: maybe.Just("Main St")}, true, "Street", "Zip")
+		if got := missingFields(t, m); !slices.Equal(got, []string{"Zip"}) {
+			t.Errorf("unexpected missing fields %v", got)
+		}
+	})
+}
+
+func TestMutuallyExclusive(t *testing.T) {
+	t.Run("accepts at most one present field", func(t *testing.T) {
+		for name, a := range map[string]address{
+			"none": {},
+			"one":  {Street: maybe.Just("Main St")},
+		} {
+			if !present.MutuallyExclusive(a, "Street", "City").IsSome() {
+				t.Errorf("%s: expected Some", name)
+			}
+		}
+	})
+
+	t.Run("lists conflicting fields", func(t *testing.T) {
+		a := address{Street: maybe.Just("Main St"), City: maybe.Just("Springfield"), Zip: maybe.Just("12345")}
+		_, _, err := present.MutuallyExclusive(a, "Street", "Latitude", "Zip").Get()
+		var conflict *present.ConflictError
+		if !errors.As(err, &conflict) || !slices.Equal(conflict.Fields, []string{"Street", "Zip"}) {
+			t.Fatalf("expected conflict on Street and Zip, got %v", err)
+		}
+		if err.Error() != "present: mutually exclusive fields set: Street, Zip" {
+			t.Errorf("unexpected message %q", err)
+		}
+	})
+
+	t.Run("reports errors as Require does", func(t *testing.T) {
+		if !present.MutuallyExclusive(address{}, "Country").IsFailed() {
+			t.Error("expected Failure")
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package proj
+
+import "github.com/lonelywolflee/lw-project-fp-go/maybe"
+
+// Field lifts a getter into a function over Maybe, so a projection used in many chains
+// can be declared once as a named value.
+//
+// Behavior of the returned function:
+//   - If the input is Some: returns Just(get(value))
+//   - If the input is None or Failure: returns it with the type changed (get not called)
+//   - If get panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	var userEmail = proj.Field(func(u User) string { return u.Email })
+//
+//	email := userEmail(findUser(id)) // Maybe[string]
+func Field[T, R any](get func(T) R) func(maybe.Maybe[T]) maybe.Maybe[R] {
+	return func(m maybe.Maybe[T]) maybe.Maybe[R] {
+		return maybe.Map(m, get)
+	}
+}
+
+// Optional is Field for getters of fields that are themselves optional, flattening the result.
+//
+// Example:
+//
+//	var userNickname = proj.Optional(func(u User) maybe.Maybe[string] { return u.Nickname })
+//
+//	nickname := userNickname(findUser(id)) // None if the user or the nickname is missing
+func Optional[T, R any](get func(T) maybe.Maybe[R]) func(maybe.Maybe[T]) maybe.Maybe[R] {
+	return func(m maybe.Maybe[T]) maybe.Maybe[R] {
+		return maybe.FlatMap(m, get)
+	}
+}
+
+// Compose chains two projections into one, for reaching nested fields.
+//
+// Example:
+//
+//	var userCity = proj.Compose(
+//	    proj.Field(func(u User) Address { return u.Address }),
+//	    proj.Field(func(a Address) string { return a.City }),
+//	)
+//
+//	city := userCity(findUser(id))
+func Compose[A, B, C any](first func(maybe.Maybe[A]) maybe.Maybe[B], second func(maybe.Maybe[B]) maybe.Maybe[C]) func(maybe.Maybe[A]) maybe.Maybe[C] {
+	return func(m maybe.Maybe[A]) maybe.Maybe[C] {
+		return second(first(m))
+	}
+}
@@ -0,0 +1,73 @@
+package proj_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/proj"
+)
+
+type address struct{ City string }
+
+type user struct {
+	Email    string
+	Nickname maybe.Maybe[string]
+	Address  address
+}
+
+var (
+	userEmail    = proj.Field(func(u user) string { return u.Email })
+	userNickname = proj.Optional(func(u user) maybe.Maybe[string] { return u.Nickname })
+	userCity     = proj.Compose(
+		proj.Field(func(u user) address { return u.Address }),
+		proj.Field(func(a address) string { return a.City }),
+	)
+)
+
+func TestField(t *testing.T) {
+	t.Run("projects Some", func(t *testing.T) {
+		if got := userEmail(maybe.Just(user{Email: "a@example.com"})).OrPanic(); got != "a@example.com" {
+			t.Errorf("unexpected email %s", got)
+		}
+	})
+
+	t.Run("propagates None and Failure", func(t *testing.T) {
+		err := errors.New("lookup failed")
+		if !userEmail(maybe.Empty[user]()).IsNone() {
+			t.Error("expected None")
+		}
+		if !userEmail(maybe.Failed[user](err)).ErrIs(err) {
+			t.Error("expected original Failure")
+		}
+	})
+
+	t.Run("converts panic to Failure", func(t *testing.T) {
+		broken := proj.Field(func(u *user) string { return u.Email })
+		if !broken(maybe.Just[*user](nil)).IsFailed() {
+			t.Error("expected Failure")
+		}
+	})
+}
+
+func TestOptional(t *testing.T) {
+	t.Run("flattens optional field", func(t *testing.T) {
+		if got := userNickname(maybe.Just(user{Nickname: maybe.Just("al")})).OrPanic(); got != "al" {
+			t.Errorf("unexpected nickname %s", got)
+		}
+		if !userNickname(maybe.Just(user{Nickname: maybe.Empty[string]()})).IsNone() {
+			t.Error("expected None for missing nickname")
+		}
+	})
+}
+
+func TestCompose(t *testing.T) {
+	t.Run("reaches nested field", func(t *testing.T) {
+		if got := userCity(maybe.Just(user{Address: address{City: "Oslo"}})).OrPanic(); got != "Oslo" {
+			t.Errorf("unexpected city %s", got)
+		}
+		if !userCity(maybe.Empty[user]()).IsNone() {
+			t.Error("expected None")
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package queue
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Queue is an in-memory priority and delay queue that is safe for concurrent use.
+// Items become available once their delay has elapsed; among available items,
+// the one with the highest priority is dequeued first, and items with equal
+// priority are dequeued in insertion order.
+//
+// Dequeue returns a Maybe instead of blocking, so "nothing to do yet" is modeled
+// as None and composes with the rest of the maybe API.
+//
+// Example:
+//
+//	q := queue.New[Job]()
+//	q.Enqueue(job, 0)
+//
+//	// Retry a failed job with backoff
+//	q.EnqueueAfter(job, 0, 2*time.Second)
+//
+//	q.Dequeue().Then(process) // processes the next ready job, if any
+type Queue[T any] struct {
+	mu      sync.Mutex
+	ready   readyHeap[T]
+	delayed delayedHeap[T]
+	seq     uint64
+	clock   clock.Clock
+}
+
+type item[T any] struct {
+	v        T
+	priority int
+	readyAt  time.Time
+	seq      uint64
+}
+
+// New creates an empty Queue.
+//
+// Example:
+//
+//	q := queue.New[string]()
+func New[T any]() *Queue[T] {
+	return NewWithClock[T](clock.System)
+}
+
+// NewWithClock creates an empty Queue that measures delays with c.
+// A nil Clock uses clock.System.
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Now())
+//	q := queue.NewWithClock[string](fake)
+func NewWithClock[T any](c clock.Clock) *Queue[T] {
+	return &Queue[T]{clock: clock.OrSystem(c)}
+}
+
+// Enqueue adds a value that is available immediately.
+// Higher priority values are dequeued before lower priority ones.
+//
+// Example:
+//
+//	q.Enqueue("urgent", 10)
+//	q.Enqueue("normal", 0)
+//	q.Dequeue() // Just("urgent")
+func (q *Queue[T]) Enqueue(v T, priority int) {
+	q.EnqueueAfter(v, priority, 0)
+}
+
+// EnqueueAfter adds a value that becomes available once delay has elapsed.
+// A non-positive delay makes the value available immediately.
+//
+// Example:
+//
+//	q.EnqueueAfter(job, 0, time.Second)
+//	q.Dequeue() // Empty[Job]() until one second has passed
+func (q *Queue[T]) EnqueueAfter(v T, priority int, delay time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.seq++
+	it := &item[T]{v: v, priority: priority, seq: q.seq}
+	if delay <= 0 {
+		heap.Push(&q.ready, it)
+		return
+	}
+	it.readyAt = q.clock.Now().Add(delay)
+	heap.Push(&q.delayed, it)
+}
+
+// Dequeue removes and returns the highest priority value that is available.
+//
+// Behavior:
+//   - If a value is available: returns Just(value)
+//   - If the queue is empty or every value is still delayed: returns None
+//
+// Example:
+//
+//	q.Enqueue(42, 0)
+//	q.Dequeue() // Just(42)
+//	q.Dequeue() // Empty[int]()
+func (q *Queue[T]) Dequeue() maybe.Maybe[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.promote()
+	if q.ready.Len() == 0 {
+		return maybe.Empty[T]()
+	}
+	it := heap.Pop(&q.ready).(*item[T])
+	return maybe.Just(it.v)
+}
+
+// Len returns the number of values in the queue, including delayed ones.
+//
+// Example:
+//
+//	q.Enqueue(1, 0)
+//	q.EnqueueAfter(2, 0, time.Minute)
+//	q.Len() // 2
+func (q *Queue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.ready.Len() + q.delayed.Len()
+}
+
+// promote moves every delayed item whose delay has elapsed into the ready heap.
+// The caller must hold q.mu.
+func (q *Queue[T]) promote() {
+	now := q.clock.Now()
+	for q.delayed.Len() > 0 && !q.delayed[0].readyAt.After(now) {
+		heap.Push(&q.ready, heap.Pop(&q.delayed))
+	}
+}
+
+// readyHeap orders items by descending priority, then by insertion order.
+type readyHeap[T any] []*item[T]
+
+func (h readyHeap[T]) Len() int { return len(h) }
+func (h readyHeap[T]) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+func (h readyHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *readyHeap[T]) Push(x any)   { *h = append(*h, x.(*item[T])) }
+func (h *readyHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return it
+}
+
+// delayedHeap orders items by the time they become ready, then by insertion order.
+type delayedHeap[T any] []*item[T]
+
+func (h delayedHeap[T]) Len() int { return len(h) }
+func (h delayedHeap[T]) Less(i, j int) bool {
+	if !h[i].readyAt.Equal(h[j].readyAt) {
+		return h[i].readyAt.Before(h[j].readyAt)
+	}
+	return h[i].seq < h[j].seq
+}
+func (h delayedHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *delayedHeap[T]) Push(x any)   { *h = append(*h, x.(*item[T])) }
+func (h *delayedHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return it
+}
@@ -0,0 +1,166 @@
+package queue_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/queue"
+)
+
+func TestQueue_Dequeue(t *testing.T) {
+	t.Run("returns None for empty queue", func(t *testing.T) {
+		q := queue.New[int]()
+
+		if _, ok := q.Dequeue().(maybe.None[int]); !ok {
+			t.Fatal("Dequeue on empty queue should return None")
+		}
+	})
+
+	t.Run("returns values by descending priority", func(t *testing.T) {
+		q := queue.New[string]()
+		q.Enqueue("low", 1)
+		q.Enqueue("high", 10)
+		q.Enqueue("mid", 5)
+
+		for _, want := range []string{"high", "mid", "low"} {
+			value, ok, _ := q.Dequeue().Get()
+			if !ok || value != want {
+				t.Errorf("expected %q, got %q (ok=%v)", want, value, ok)
+			}
+		}
+	})
+
+	t.Run("keeps insertion order for equal priority", func(t *testing.T) {
+		q := queue.New[int]()
+		for i := 1; i <= 5; i++ {
+			q.Enqueue(i, 0)
+		}
+
+		for want := 1; want <= 5; want++ {
+			value, _, _ := q.Dequeue().Get()
+			if value != want {
+				t.Errorf("expected %d, got %d", want, value)
+			}
+		}
+	})
+
+	t.Run("withholds delayed values until ready", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		q := queue.NewWithClock[int](fake)
+		q.EnqueueAfter(42, 0, time.Minute)
+
+		if _, ok := q.Dequeue().(maybe.None[int]); !ok {
+			t.Fatal("delayed value should not be available yet")
+		}
+		if q.Len() != 1 {
+			t.Errorf("expected Len 1, got %d", q.Len())
+		}
+
+		fake.Advance(59 * time.Second)
+		if _, ok := q.Dequeue().(maybe.None[int]); !ok {
+			t.Fatal("delayed value should not be available before its delay")
+		}
+
+		fake.Advance(time.Second)
+		value, ok, _ := q.Dequeue().Get()
+		if !ok || value != 42 {
+			t.Errorf("expected Just(42) after delay, got %d (ok=%v)", value, ok)
+		}
+	})
+
+	t.Run("keeps insertion order for equal delays", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		q := queue.NewWithClock[int](fake)
+		for i := 1; i <= 3; i++ {
+			q.EnqueueAfter(i, 0, time.Second)
+		}
+
+		fake.Advance(time.Second)
+		for want := 1; want <= 3; want++ {
+			if value, _, _ := q.Dequeue().Get(); value != want {
+				t.Errorf("expected %d, got %d", want, value)
+			}
+		}
+	})
+
+	t.Run("nil clock falls back to the system clock", func(t *testing.T) {
+		q := queue.NewWithClock[int](nil)
+		q.EnqueueAfter(1, 0, time.Millisecond)
+
+		time.Sleep(5 * time.Millisecond)
+		if _, ok := q.Dequeue().(maybe.Some[int]); !ok {
+			t.Fatal("expected value after real delay")
+		}
+	})
+
+	t.Run("non-positive delay is immediately available", func(t *testing.T) {
+		q := queue.New[int]()
+		q.EnqueueAfter(7, 0, -time.Second)
+
+		value, ok, _ := q.Dequeue().Get()
+		if !ok || value != 7 {
+			t.Errorf("expected Just(7), got %d (ok=%v)", value, ok)
+		}
+	})
+
+	t.Run("ready delayed values compete by priority", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		q := queue.NewWithClock[string](fake)
+		q.EnqueueAfter("delayed-high", 10, 10*time.Second)
+		q.EnqueueAfter("delayed-low", 1, 5*time.Second)
+		q.Enqueue("now", 5)
+
+		fake.Advance(10 * time.Second)
+		for _, want := range []string{"delayed-high", "now", "delayed-low"} {
+			value, _, _ := q.Dequeue().Get()
+			if value != want {
+				t.Errorf("expected %q, got %q", want, value)
+			}
+		}
+	})
+}
+
+func TestQueue_Len(t *testing.T) {
+	t.Run("counts ready and delayed values", func(t *testing.T) {
+		q := queue.New[int]()
+		q.Enqueue(1, 0)
+		q.EnqueueAfter(2, 0, time.Minute)
+
+		if q.Len() != 2 {
+			t.Errorf("expected 2, got %d", q.Len())
+		}
+		q.Dequeue()
+		if q.Len() != 1 {
+			t.Errorf("expected 1, got %d", q.Len())
+		}
+	})
+}
+
+func TestQueue_Concurrent(t *testing.T) {
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		q := queue.New[int]()
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				q.Enqueue(i, i%3)
+			}(i)
+		}
+		wg.Wait()
+
+		count := 0
+		for {
+			if _, ok, _ := q.Dequeue().Get(); !ok {
+				break
+			}
+			count++
+		}
+		if count != 100 {
+			t.Errorf("expected 100 values, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package randsrc
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+)
+
+// Source is a source of random numbers for components that need randomness,
+// such as jitter or probabilistic sampling. Implementations must be safe for concurrent use.
+//
+// Production code uses Default; tests use New with a fixed seed so runs are reproducible.
+type Source interface {
+	// Uint64 returns a uniformly distributed 64-bit value.
+	Uint64() uint64
+	// Float64 returns a uniformly distributed value in [0, 1).
+	Float64() float64
+	// IntN returns a uniformly distributed value in [0, n). It panics if n <= 0.
+	IntN(n int) int
+}
+
+// Default is a Source backed by crypto/rand. It is unpredictable and needs no seeding.
+var Default Source = rand.New(cryptoSource{})
+
+// OrDefault returns s, or Default if s is nil.
+// Components use it to treat an unset Source as the production one.
+func OrDefault(s Source) Source {
+	if s == nil {
+		return Default
+	}
+	return s
+}
+
+// New returns a deterministic Source seeded with seed.
+// Two sources created with the same seed produce the same sequence.
+//
+// Example:
+//
+//	src := randsrc.New(42)
+//	src.IntN(10) // same value on every run
+func New(seed uint64) Source {
+	return rand.New(&lockedSource{src: rand.NewPCG(seed, seed)})
+}
+
+// cryptoSource adapts crypto/rand to rand.Source.
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	cryptorand.Read(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// lockedSource makes a rand.Source safe for concurrent use.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Uint64()
+}
@@ -0,0 +1,87 @@
+package randsrc_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/randsrc"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("same seed produces the same sequence", func(t *testing.T) {
+		a := randsrc.New(42)
+		b := randsrc.New(42)
+
+		for i := 0; i < 10; i++ {
+			if x, y := a.Uint64(), b.Uint64(); x != y {
+				t.Fatalf("step %d: sequences diverged (%d != %d)", i, x, y)
+			}
+		}
+	})
+
+	t.Run("different seeds produce different sequences", func(t *testing.T) {
+		if randsrc.New(1).Uint64() == randsrc.New(2).Uint64() {
+			t.Error("expected different first values for different seeds")
+		}
+	})
+
+	t.Run("values are within range", func(t *testing.T) {
+		src := randsrc.New(7)
+		for i := 0; i < 1000; i++ {
+			if f := src.Float64(); f < 0 || f >= 1 {
+				t.Fatalf("Float64 out of range: %v", f)
+			}
+			if n := src.IntN(5); n < 0 || n >= 5 {
+				t.Fatalf("IntN out of range: %d", n)
+			}
+		}
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		src := randsrc.New(1)
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				src.IntN(100)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestDefault(t *testing.T) {
+	t.Run("produces varying values", func(t *testing.T) {
+		seen := map[uint64]bool{}
+		for i := 0; i < 10; i++ {
+			seen[randsrc.Default.Uint64()] = true
+		}
+		if len(seen) < 2 {
+			t.Error("Default should not repeat the same value")
+		}
+	})
+
+	t.Run("values are within range", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			if f := randsrc.Default.Float64(); f < 0 || f >= 1 {
+				t.Fatalf("Float64 out of range: %v", f)
+			}
+		}
+	})
+}
+
+func TestOrDefault(t *testing.T) {
+	t.Run("returns Default for nil", func(t *testing.T) {
+		if randsrc.OrDefault(nil) != randsrc.Default {
+			t.Error("expected Default for nil source")
+		}
+	})
+
+	t.Run("returns the given source", func(t *testing.T) {
+		src := randsrc.New(1)
+		if randsrc.OrDefault(src) != src {
+			t.Error("expected the given source")
+		}
+	})
+}
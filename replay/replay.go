@@ -0,0 +1,178 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+var (
+	// ErrNotRecorded is returned in replay mode when a step is called more often than it was recorded.
+	ErrNotRecorded = errors.New("replay: no recorded call left for step")
+	// ErrDiverged is returned in replay mode when a step is called with a different input than was recorded.
+	ErrDiverged = errors.New("replay: input differs from recording")
+)
+
+// Entry is one recorded call of a step. Inputs and outputs are stored as JSON, so a Log's
+// entries can be saved with encoding/json and loaded again in a test.
+type Entry struct {
+	// Step is the name the step was wrapped with.
+	Step string `json:"step"`
+	// Input is the JSON encoding of the argument.
+	Input json.RawMessage `json:"input"`
+	// Output is the JSON encoding of the value, set when the step returned Some.
+	Output json.RawMessage `json:"output,omitempty"`
+	// Error is the error message, set when the step returned Failure.
+	// A step that returned None has neither Output nor Error.
+	Error string `json:"error,omitempty"`
+}
+
+// Log is either a recording of step calls or a recording being replayed. It is safe for
+// concurrent use, although replay is only deterministic if each step is called in the
+// same order as when it was recorded.
+type Log struct {
+	mu        sync.Mutex
+	replaying bool
+	entries   []Entry
+	next      map[string]int
+}
+
+// NewRecorder creates a Log that performs every step and records its input and result.
+//
+// Example:
+//
+//	log := replay.NewRecorder()
+//	fetch := replay.Step(log, "fetch-user", fetchUser)
+//	...
+//	json.NewEncoder(file).Encode(log.Entries()) // capture for later reproduction
+func NewRecorder() *Log {
+	return &Log{}
+}
+
+// NewReplayer creates a Log that never performs a step, and instead returns the result
+// recorded for it in entries.
+//
+// Example:
+//
+//	var entries []replay.Entry
+//	json.Unmarshal(captured, &entries)
+//
+//	log := replay.NewReplayer(entries)
+//	fetch := replay.Step(log, "fetch-user", fetchUser) // fetchUser is never called
+func NewReplayer(entries []Entry) *Log {
+	return &Log{replaying: true, entries: slices.Clone(entries), next: map[string]int{}}
+}
+
+// Entries returns a copy of the recorded entries, in the order the calls finished.
+func (l *Log) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.entries)
+}
+
+func (l *Log) append(e Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, e)
+}
+
+// take returns the next unreplayed entry for step.
+func (l *Log) take(step string) (Entry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := l.next[step]; i < len(l.entries); i++ {
+		if l.entries[i].Step == step {
+			l.next[step] = i + 1
+			return l.entries[i], true
+		}
+	}
+	l.next[step] = len(l.entries)
+	return Entry{}, false
+}
+
+// Step wraps an effectful function so its calls go through log. Wrap each effect of a
+// chain (database reads, HTTP calls, clock reads) with a stable, unique name; pure steps
+// need no wrapping since they produce the same result from the same input.
+//
+// Behavior when recording:
+//   - Calls fn and records the input and its Some, None or Failure result
+//   - If fn panics: the panic is converted to a Failure, which is recorded
+//   - If the input cannot be encoded: returns Failure without calling fn
+//   - If the output cannot be encoded: returns Failure, although the effect has been applied
+//
+// Behavior when replaying:
+//   - Returns the result of the step's next recorded call without calling fn
+//   - A recorded Failure is returned as an error with the recorded message; sentinel
+//     identity is not preserved, so errors.Is against the original sentinel does not match
+//   - If the input differs from the recorded one: returns Failure wrapping ErrDiverged
+//   - If no recorded call is left for the step: returns Failure wrapping ErrNotRecorded
+//
+// Example:
+//
+//	log := replay.NewReplayer(incident)
+//	order := maybe.FlatMap(orderID, replay.Step(log, "load-order", loadOrder))
+//	total := maybe.FlatMap(order, replay.Step(log, "fetch-prices", fetchPrices)).
+//	    Map(applyDiscounts) // reproduces the production result using captured data
+func Step[A, B any](log *Log, name string, fn func(A) maybe.Maybe[B]) func(A) maybe.Maybe[B] {
+	return func(a A) maybe.Maybe[B] {
+		input, err := json.Marshal(a)
+		if err != nil {
+			return maybe.Failed[B](fmt.Errorf("replay: encode input of step %q: %w", name, err))
+		}
+		if log.replaying {
+			return replayStep[B](log, name, input)
+		}
+		return recordStep(log, name, input, maybe.Do(func() maybe.Maybe[B] { return fn(a) }))
+	}
+}
+
+func recordStep[B any](log *Log, name string, input []byte, result maybe.Maybe[B]) maybe.Maybe[B] {
+	entry := Entry{Step: name, Input: input}
+	value, ok, err := result.Get()
+	switch {
+	case err != nil:
+		entry.Error = err.Error()
+	case ok:
+		output, err := json.Marshal(value)
+		if err != nil {
+			return maybe.Failed[B](fmt.Errorf("replay: step %q applied but output not recorded: %w", name, err))
+		}
+		entry.Output = output
+	}
+	log.append(entry)
+	return result
+}
+
+func replayStep[B any](log *Log, name string, input []byte) maybe.Maybe[B] {
+	entry, ok := log.take(name)
+	switch {
+	case !ok:
+		return maybe.Failed[B](fmt.Errorf("%w %q", ErrNotRecorded, name))
+	case !sameJSON(entry.Input, input):
+		return maybe.Failed[B](fmt.Errorf("%w: step %q recorded %s, got %s", ErrDiverged, name, entry.Input, input))
+	case entry.Error != "":
+		return maybe.Failed[B](errors.New(entry.Error))
+	case len(entry.Output) == 0:
+		return maybe.Empty[B]()
+	}
+	var value B
+	if err := json.Unmarshal(entry.Output, &value); err != nil {
+		return maybe.Failed[B](fmt.Errorf("replay: decode output of step %q: %w", name, err))
+	}
+	return maybe.Just(value)
+}
+
+// sameJSON compares two JSON encodings ignoring insignificant whitespace, which saving
+// the entries with indentation adds.
+func sameJSON(a, b []byte) bool {
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, a) != nil || json.Compact(&cb, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
@@ -0,0 +1,158 @@
+package replay_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/replay"
+)
+
+var errNotFound = errors.New("not found")
+
+type user struct {
+	ID   int
+	Name string
+}
+
+// lookup is an effect whose result depends on the input and on how often it ran.
+func lookup(calls *int) func(int) maybe.Maybe[user] {
+	return func(id int) maybe.Maybe[user] {
+		*calls++
+		switch id {
+		case 0:
+			return maybe.Empty[user]()
+		case -1:
+			return maybe.Failed[user](errNotFound)
+		default:
+			return maybe.Just(user{ID: id, Name: "user" + strconv.Itoa(*calls)})
+		}
+	}
+}
+
+// roundTrip saves entries as indented JSON and loads them back, as a captured log would be.
+func roundTrip(t *testing.T, entries []replay.Entry) []replay.Entry {
+	t.Helper()
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded []replay.Entry
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatal(err)
+	}
+	return loaded
+}
+
+func TestStep(t *testing.T) {
+	t.Run("replays recorded results without performing the effect", func(t *testing.T) {
+		calls := 0
+		recorder := replay.NewRecorder()
+		record := replay.Step(recorder, "lookup", lookup(&calls))
+		recorded := []maybe.Maybe[user]{record(7), record(0), record(-1)}
+
+		replayer := replay.NewReplayer(roundTrip(t, recorder.Entries()))
+		play := replay.Step(replayer, "lookup", lookup(&calls))
+		replayed := []maybe.Maybe[user]{play(7), play(0), play(-1)}
+
+		if calls != 3 {
+			t.Errorf("expected effect to run only while recording, ran %d times", calls)
+		}
+		if !maybe.Equal(replayed[0], recorded[0]) || !replayed[1].IsNone() {
+			t.Errorf("expected recorded values, got %v and %v", replayed[0], replayed[1])
+		}
+		if _, _, err := replayed[2].Get(); err == nil || err.Error() != errNotFound.Error() {
+			t.Errorf("expected recorded error message, got %v", err)
+		}
+	})
+
+	t.Run("replays each step in its own order", func(t *testing.T) {
+		calls := 0
+		recorder := replay.NewRecorder()
+		a := replay.Step(recorder, "a", lookup(&calls))
+		b := replay.Step(recorder, "b", func(n int) maybe.Maybe[int] { return maybe.Just(n * 10) })
+		a(1)
+		b(2)
+		a(3)
+
+		replayer := replay.NewReplayer(recorder.Entries())
+		b2 := replay.Step(replayer, "b", func(int) maybe.Maybe[int] { return maybe.Empty[int]() })
+		a2 := replay.Step(replayer, "a", lookup(&calls))
+		if got := b2(2).OrPanic(); got != 20 {
+			t.Errorf("expected 20, got %d", got)
+		}
+		if got := a2(1).OrPanic(); got.Name != "user1" {
+			t.Errorf("expected first recorded lookup, got %v", got)
+		}
+		if got := a2(3).OrPanic(); got.Name != "user2" {
+			t.Errorf("expected second recorded lookup, got %v", got)
+		}
+	})
+
+	t.Run("fails when input diverges", func(t *testing.T) {
+		calls := 0
+		recorder := replay.NewRecorder()
+		replay.Step(recorder, "lookup", lookup(&calls))(7)
+
+		play := replay.Step(replay.NewReplayer(recorder.Entries()), "lookup", lookup(&calls))
+		if !play(8).ErrIs(replay.ErrDiverged) {
+			t.Error("expected ErrDiverged")
+		}
+	})
+
+	t.Run("fails when calls exceed the recording", func(t *testing.T) {
+		calls := 0
+		recorder := replay.NewRecorder()
+		replay.Step(recorder, "lookup", lookup(&calls))(7)
+
+		play := replay.Step(replay.NewReplayer(recorder.Entries()), "lookup", lookup(&calls))
+		play(7)
+		if !play(7).ErrIs(replay.ErrNotRecorded) {
+			t.Error("expected ErrNotRecorded")
+		}
+	})
+
+	t.Run("records a panic as Failure", func(t *testing.T) {
+		recorder := replay.NewRecorder()
+		result := replay.Step(recorder, "boom", func(int) maybe.Maybe[int] { panic("boom") })(1)
+
+		entries := recorder.Entries()
+		if !result.IsFailed() || len(entries) != 1 || entries[0].Error == "" {
+			t.Errorf("expected recorded Failure, got %v and %+v", result, entries)
+		}
+	})
+
+	t.Run("fails without calling fn when input cannot be encoded", func(t *testing.T) {
+		called := false
+		step := replay.Step(replay.NewRecorder(), "ch", func(chan int) maybe.Maybe[int] { called = true; return maybe.Just(1) })
+		if !step(make(chan int)).IsFailed() || called {
+			t.Error("expected Failure without calling fn")
+		}
+	})
+
+	t.Run("fails when output cannot be encoded", func(t *testing.T) {
+		recorder := replay.NewRecorder()
+		step := replay.Step(recorder, "ch", func(int) maybe.Maybe[chan int] { return maybe.Just(make(chan int)) })
+		if !step(1).IsFailed() || len(recorder.Entries()) != 0 {
+			t.Error("expected Failure and nothing recorded")
+		}
+	})
+
+	t.Run("fails when recorded output does not decode", func(t *testing.T) {
+		entries := []replay.Entry{{Step: "n", Input: json.RawMessage(`1`), Output: json.RawMessage(`"text"`)}}
+		step := replay.Step(replay.NewReplayer(entries), "n", func(int) maybe.Maybe[int] { return maybe.Just(1) })
+		if !step(1).IsFailed() {
+			t.Error("expected Failure")
+		}
+	})
+
+	t.Run("compares malformed recorded input byte for byte", func(t *testing.T) {
+		entries := []replay.Entry{{Step: "n", Input: json.RawMessage(`{`), Output: json.RawMessage(`2`)}}
+		step := replay.Step(replay.NewReplayer(entries), "n", func(int) maybe.Maybe[int] { return maybe.Just(1) })
+		if !step(1).ErrIs(replay.ErrDiverged) {
+			t.Error("expected ErrDiverged")
+		}
+	})
+}
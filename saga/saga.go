@@ -0,0 +1,89 @@
+package saga
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Step is a single unit of a saga: an action and the compensation that undoes it.
+//
+// Do receives the state produced by the previous step and returns the next state.
+// Compensate receives the state that Do produced and should undo its effect.
+// A nil Compensate means the step has nothing to undo.
+//
+// Example:
+//
+//	reserve := saga.Step[Order]{
+//	    Do: func(o Order) (Order, error) {
+//	        o.ReservationID, err = inventory.Reserve(o.Items)
+//	        return o, err
+//	    },
+//	    Compensate: func(o Order) error {
+//	        return inventory.Release(o.ReservationID)
+//	    },
+//	}
+type Step[T any] struct {
+	Do         func(T) (T, error)
+	Compensate func(T) error
+}
+
+// Run executes the steps in order starting from the zero value of T.
+// It is equivalent to RunFrom with a zero initial state.
+//
+// Example:
+//
+//	result := saga.Run(createUser, createAccount, sendWelcome)
+func Run[T any](steps ...Step[T]) maybe.Maybe[T] {
+	var zero T
+	return RunFrom(zero, steps...)
+}
+
+// RunFrom executes the steps in order, threading the state from one step to the next.
+//
+// Behavior:
+//   - If every step succeeds: returns Just(final state)
+//   - If a step fails or panics: compensates the previously completed steps in reverse order
+//     and returns Failure with the step error joined with any compensation errors
+//   - Compensation panics are caught and reported as compensation errors
+//
+// Example:
+//
+//	result := saga.RunFrom(order, reserve, charge, ship)
+//	// If charge fails, reserve is compensated and the result is
+//	// Failed[Order]("saga step 1: card declined")
+func RunFrom[T any](initial T, steps ...Step[T]) maybe.Maybe[T] {
+	state := initial
+	completed := make([]T, 0, len(steps))
+
+	for i, step := range steps {
+		next, err := maybe.Try(func() (T, error) {
+			return step.Do(state)
+		}).OrError()
+		if err != nil {
+			errs := []error{fmt.Errorf("saga step %d: %w", i, err)}
+			for j := len(completed) - 1; j >= 0; j-- {
+				if cerr := compensate(steps[j], completed[j]); cerr != nil {
+					errs = append(errs, fmt.Errorf("saga compensate step %d: %w", j, cerr))
+				}
+			}
+			return maybe.Failed[T](errors.Join(errs...))
+		}
+		completed = append(completed, next)
+		state = next
+	}
+
+	return maybe.Just(state)
+}
+
+// compensate runs the step's compensation with panic recovery.
+func compensate[T any](step Step[T], state T) error {
+	if step.Compensate == nil {
+		return nil
+	}
+	_, err := maybe.Try(func() (struct{}, error) {
+		return struct{}{}, step.Compensate(state)
+	}).OrError()
+	return err
+}
@@ -0,0 +1,169 @@
+package saga_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/saga"
+)
+
+func recordingStep(name string, log *[]string, fail error) saga.Step[int] {
+	return saga.Step[int]{
+		Do: func(x int) (int, error) {
+			*log = append(*log, "do "+name)
+			if fail != nil {
+				return 0, fail
+			}
+			return x + 1, nil
+		},
+		Compensate: func(x int) error {
+			*log = append(*log, "undo "+name)
+			return nil
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("starts from zero value and threads state", func(t *testing.T) {
+		var log []string
+		result := saga.Run(recordingStep("a", &log, nil), recordingStep("b", &log, nil))
+
+		value, ok, err := result.Get()
+		if err != nil || !ok {
+			t.Fatalf("expected Some, got err=%v ok=%v", err, ok)
+		}
+		if value != 2 {
+			t.Errorf("expected 2, got %d", value)
+		}
+		if !reflect.DeepEqual(log, []string{"do a", "do b"}) {
+			t.Errorf("unexpected log: %v", log)
+		}
+	})
+
+	t.Run("returns Some for no steps", func(t *testing.T) {
+		if _, ok := saga.Run[int]().(maybe.Some[int]); !ok {
+			t.Fatal("Run with no steps should return Some")
+		}
+	})
+}
+
+func TestRunFrom(t *testing.T) {
+	t.Run("uses the initial state", func(t *testing.T) {
+		var log []string
+		value, _, _ := saga.RunFrom(10, recordingStep("a", &log, nil)).Get()
+		if value != 11 {
+			t.Errorf("expected 11, got %d", value)
+		}
+	})
+
+	t.Run("compensates completed steps in reverse order on failure", func(t *testing.T) {
+		var log []string
+		stepErr := errors.New("boom")
+		result := saga.RunFrom(0,
+			recordingStep("a", &log, nil),
+			recordingStep("b", &log, nil),
+			recordingStep("c", &log, stepErr),
+			recordingStep("d", &log, nil),
+		)
+
+		_, _, err := result.Get()
+		if !errors.Is(err, stepErr) {
+			t.Fatalf("expected step error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "saga step 2") {
+			t.Errorf("expected error to name the failing step, got %v", err)
+		}
+		want := []string{"do a", "do b", "do c", "undo b", "undo a"}
+		if !reflect.DeepEqual(log, want) {
+			t.Errorf("expected %v, got %v", want, log)
+		}
+	})
+
+	t.Run("compensation receives the state produced by its step", func(t *testing.T) {
+		var got []int
+		step := func(fail bool) saga.Step[int] {
+			return saga.Step[int]{
+				Do: func(x int) (int, error) {
+					if fail {
+						return 0, errors.New("fail")
+					}
+					return x * 10, nil
+				},
+				Compensate: func(x int) error {
+					got = append(got, x)
+					return nil
+				},
+			}
+		}
+		saga.RunFrom(1, step(false), step(false), step(true))
+
+		if !reflect.DeepEqual(got, []int{100, 10}) {
+			t.Errorf("expected [100 10], got %v", got)
+		}
+	})
+
+	t.Run("joins compensation errors into the failure", func(t *testing.T) {
+		compErr := errors.New("cannot undo")
+		stepErr := errors.New("boom")
+		result := saga.RunFrom(0,
+			saga.Step[int]{
+				Do:         func(x int) (int, error) { return x, nil },
+				Compensate: func(x int) error { return compErr },
+			},
+			saga.Step[int]{
+				Do: func(x int) (int, error) { return 0, stepErr },
+			},
+		)
+
+		_, _, err := result.Get()
+		if !errors.Is(err, stepErr) || !errors.Is(err, compErr) {
+			t.Errorf("expected both step and compensation errors, got %v", err)
+		}
+	})
+
+	t.Run("skips nil compensation", func(t *testing.T) {
+		stepErr := errors.New("boom")
+		result := saga.RunFrom(0,
+			saga.Step[int]{Do: func(x int) (int, error) { return x, nil }},
+			saga.Step[int]{Do: func(x int) (int, error) { return 0, stepErr }},
+		)
+
+		_, _, err := result.Get()
+		if !errors.Is(err, stepErr) {
+			t.Errorf("expected step error, got %v", err)
+		}
+	})
+
+	t.Run("converts step panic to failure and compensates", func(t *testing.T) {
+		var log []string
+		result := saga.RunFrom(0,
+			recordingStep("a", &log, nil),
+			saga.Step[int]{Do: func(x int) (int, error) { panic("step panic") }},
+		)
+
+		if _, ok := result.(maybe.Failure[int]); !ok {
+			t.Fatal("expected Failure when a step panics")
+		}
+		if !reflect.DeepEqual(log, []string{"do a", "undo a"}) {
+			t.Errorf("unexpected log: %v", log)
+		}
+	})
+
+	t.Run("reports compensation panic as error", func(t *testing.T) {
+		result := saga.RunFrom(0,
+			saga.Step[int]{
+				Do:         func(x int) (int, error) { return x, nil },
+				Compensate: func(x int) error { panic("undo panic") },
+			},
+			saga.Step[int]{Do: func(x int) (int, error) { return 0, errors.New("boom") }},
+		)
+
+		_, _, err := result.Get()
+		if err == nil || !strings.Contains(err.Error(), "undo panic") {
+			t.Errorf("expected compensation panic in error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package scope
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Scope owns the goroutines started with Go. It is created by Run and must not be used
+// after Run returns.
+type Scope[T any] struct {
+	ctx     context.Context
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	results []maybe.Maybe[T]
+}
+
+// Handle refers to one goroutine started with Scope.Go.
+type Handle[T any] struct {
+	scope *Scope[T]
+	index int
+	done  chan struct{}
+}
+
+// Run calls body with a new Scope, waits for every goroutine the body started,
+// and returns their results in the order the goroutines were started.
+//
+// Behavior:
+//   - Each result is Just(value) on success, or Failure on error or panic
+//   - The context passed to goroutines is derived from ctx and cancelled once Run returns
+//   - If body itself panics, Run still waits for the started goroutines before re-panicking
+//
+// Example:
+//
+//	results := scope.Run(ctx, func(s *scope.Scope[User]) {
+//	    for _, id := range ids {
+//	        s.Go(func(ctx context.Context) (User, error) {
+//	            return repo.Find(ctx, id)
+//	        })
+//	    }
+//	})
+//	// results[i] corresponds to ids[i]
+func Run[T any](ctx context.Context, body func(s *Scope[T])) []maybe.Maybe[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s := &Scope[T]{ctx: ctx}
+	func() {
+		defer s.wg.Wait()
+		body(s)
+	}()
+	return s.results
+}
+
+// Go starts fn in a new goroutine owned by the scope and returns a Handle for its result.
+// Goroutines started by the scope may themselves call Go.
+//
+// Example:
+//
+//	h := s.Go(func(ctx context.Context) (int, error) { return count(ctx) })
+func (s *Scope[T]) Go(fn func(ctx context.Context) (T, error)) Handle[T] {
+	s.mu.Lock()
+	h := Handle[T]{scope: s, index: len(s.results), done: make(chan struct{})}
+	s.results = append(s.results, nil)
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(h.done)
+
+		result := maybe.Try(func() (T, error) {
+			return fn(s.ctx)
+		})
+		s.mu.Lock()
+		s.results[h.index] = result
+		s.mu.Unlock()
+	}()
+	return h
+}
+
+// Index returns the position of this goroutine's result in the slice returned by Run.
+func (h Handle[T]) Index() int {
+	return h.index
+}
+
+// Wait blocks until the goroutine finishes and returns its result.
+// It lets one goroutine in the scope consume another's result before Run returns.
+//
+// Example:
+//
+//	user := s.Go(fetchUser)
+//	s.Go(func(ctx context.Context) (Page, error) {
+//	    u, err := user.Wait().OrError()
+//	    ...
+//	})
+func (h Handle[T]) Wait() maybe.Maybe[T] {
+	<-h.done
+	h.scope.mu.Lock()
+	defer h.scope.mu.Unlock()
+	return h.scope.results[h.index]
+}
@@ -0,0 +1,139 @@
+package scope_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/scope"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("collects results in start order", func(t *testing.T) {
+		results := scope.Run(context.Background(), func(s *scope.Scope[int]) {
+			for i := range 5 {
+				s.Go(func(ctx context.Context) (int, error) {
+					time.Sleep(time.Duration(5-i) * time.Millisecond)
+					return i * 10, nil
+				})
+			}
+		})
+
+		if len(results) != 5 {
+			t.Fatalf("expected 5 results, got %d", len(results))
+		}
+		for i, r := range results {
+			if v, ok, _ := r.Get(); !ok || v != i*10 {
+				t.Errorf("result %d: expected %d, got %d", i, i*10, v)
+			}
+		}
+	})
+
+	t.Run("converts errors and panics to Failures", func(t *testing.T) {
+		taskErr := errors.New("boom")
+		results := scope.Run(context.Background(), func(s *scope.Scope[int]) {
+			s.Go(func(ctx context.Context) (int, error) { return 0, taskErr })
+			s.Go(func(ctx context.Context) (int, error) { panic("task panic") })
+		})
+
+		if _, _, err := results[0].Get(); !errors.Is(err, taskErr) {
+			t.Errorf("expected task error, got %v", err)
+		}
+		if _, ok := results[1].(maybe.Failure[int]); !ok {
+			t.Error("expected Failure for panicking task")
+		}
+	})
+
+	t.Run("returns no results when nothing is started", func(t *testing.T) {
+		if results := scope.Run(context.Background(), func(s *scope.Scope[int]) {}); len(results) != 0 {
+			t.Errorf("expected no results, got %v", results)
+		}
+	})
+
+	t.Run("waits for nested goroutines", func(t *testing.T) {
+		var finished atomic.Int32
+		results := scope.Run(context.Background(), func(s *scope.Scope[int]) {
+			s.Go(func(ctx context.Context) (int, error) {
+				s.Go(func(ctx context.Context) (int, error) {
+					time.Sleep(10 * time.Millisecond)
+					finished.Add(1)
+					return 2, nil
+				})
+				return 1, nil
+			})
+		})
+
+		if finished.Load() != 1 || len(results) != 2 {
+			t.Errorf("expected nested goroutine to finish, got %d results", len(results))
+		}
+	})
+
+	t.Run("cancels goroutine context after return", func(t *testing.T) {
+		var captured context.Context
+		scope.Run(context.Background(), func(s *scope.Scope[int]) {
+			s.Go(func(ctx context.Context) (int, error) {
+				captured = ctx
+				return 0, ctx.Err()
+			})
+		})
+
+		if captured.Err() == nil {
+			t.Error("expected scope context to be cancelled after Run")
+		}
+	})
+
+	t.Run("waits for goroutines before re-panicking", func(t *testing.T) {
+		var finished atomic.Bool
+		defer func() {
+			if recover() == nil {
+				t.Error("expected body panic to propagate")
+			}
+			if !finished.Load() {
+				t.Error("expected started goroutine to finish before panic propagated")
+			}
+		}()
+
+		scope.Run(context.Background(), func(s *scope.Scope[int]) {
+			s.Go(func(ctx context.Context) (int, error) {
+				time.Sleep(10 * time.Millisecond)
+				finished.Store(true)
+				return 0, nil
+			})
+			panic("body panic")
+		})
+	})
+}
+
+func TestHandle(t *testing.T) {
+	t.Run("Wait returns another goroutine's result", func(t *testing.T) {
+		results := scope.Run(context.Background(), func(s *scope.Scope[int]) {
+			first := s.Go(func(ctx context.Context) (int, error) { return 20, nil })
+			s.Go(func(ctx context.Context) (int, error) {
+				v, err := first.Wait().OrError()
+				return v + 1, err
+			})
+		})
+
+		if v, _, _ := results[1].Get(); v != 21 {
+			t.Errorf("expected 21, got %d", v)
+		}
+	})
+
+	t.Run("Index matches result position", func(t *testing.T) {
+		var handles []scope.Handle[string]
+		results := scope.Run(context.Background(), func(s *scope.Scope[string]) {
+			handles = append(handles,
+				s.Go(func(ctx context.Context) (string, error) { return "a", nil }),
+				s.Go(func(ctx context.Context) (string, error) { return "b", nil }))
+		})
+
+		for i, h := range handles {
+			if h.Index() != i || h.Wait() != results[i] {
+				t.Errorf("handle %d: index %d does not match results", i, h.Index())
+			}
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package seq
+
+import (
+	"iter"
+
+	"github.com/lonelywolflee/lw-project-fp-go/bloom"
+)
+
+// DedupeApprox yields each distinct value of s once, remembering the values it has seen in
+// f, so memory stays fixed however long the key stream is. Like bloom.Dedupe it never lets
+// a duplicate through, but drops a small fraction of new values as false positives. Values
+// already added to f before ranging count as seen, so one filter can dedupe across runs.
+//
+// Example:
+//
+//	seen := bloom.New[string](10_000_000, 0.001)
+//	for id := range seq.DedupeApprox(eventIDs, seen) {
+//	    process(id) // each ID at most once
+//	}
+func DedupeApprox[T comparable](s iter.Seq[T], f *bloom.Filter[T]) iter.Seq[T] {
+	firstSeen := bloom.Dedupe(f)
+	return func(yield func(T) bool) {
+		for v := range s {
+			if firstSeen(v).IsSome() && !yield(v) {
+				return
+			}
+		}
+	}
+}
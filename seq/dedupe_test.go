@@ -0,0 +1,60 @@
+package seq_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/bloom"
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+func TestDedupeApprox(t *testing.T) {
+	t.Run("yields each value once in first-seen order", func(t *testing.T) {
+		ids := slices.Values([]string{"a", "b", "a", "c", "b", "a"})
+		got := slices.Collect(seq.DedupeApprox(ids, bloom.New[string](100, 0.001)))
+		if !slices.Equal(got, []string{"a", "b", "c"}) {
+			t.Errorf("expected [a b c], got %v", got)
+		}
+	})
+
+	t.Run("treats values already in the filter as seen", func(t *testing.T) {
+		f := bloom.New[int](100, 0.001)
+		f.Add(1)
+		got := slices.Collect(seq.DedupeApprox(seq.RangeSeq(0, 3, 1), f))
+		if !slices.Equal(got, []int{0, 2}) {
+			t.Errorf("expected [0 2], got %v", got)
+		}
+		if !f.MaybeContains(2) {
+			t.Error("expected yielded values to be added to the filter")
+		}
+	})
+
+	t.Run("never yields a duplicate over a long stream", func(t *testing.T) {
+		source := func(yield func(int) bool) {
+			for v := range 20_000 {
+				if !yield(v % 5_000) {
+					return
+				}
+			}
+		}
+		got := slices.Collect(seq.DedupeApprox(source, bloom.New[int](5_000, 0.01)))
+		if len(got) > 5_000 || len(got) < 4_900 {
+			t.Errorf("expected close to 5000 distinct values, got %d", len(got))
+		}
+		slices.Sort(got)
+		if len(slices.Compact(got)) != len(got) {
+			t.Error("expected no duplicates")
+		}
+	})
+
+	t.Run("stops when the consumer stops", func(t *testing.T) {
+		count := 0
+		for range seq.DedupeApprox(seq.RangeSeq(0, 10, 1), bloom.New[int](10, 0.01)) {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("expected one value, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package seq
+
+import (
+	"iter"
+	"sync"
+)
+
+// Partition splits s by pred into two sequences that can be consumed independently:
+// matched yields the values for which pred returned true and rest the others, each in
+// their original order, so valid and invalid values can be routed to different sinks
+// (persist vs dead-letter) without a second pass over the source.
+//
+// Behavior:
+//   - The source is read once, lazily, through a single iter.Pull shared by both halves
+//   - A half may be ranged over before, after, or interleaved with the other, from the
+//     same goroutine or different ones
+//   - Values pulled for the other half wait in its queue until it is ranged over, so
+//     draining one half of a long source buffers every value of the other
+//   - The source is stopped once it is exhausted, or once both halves have been ranged
+//     over and left; ranging over a half again after that yields only its queued values
+//
+// Example:
+//
+//	valid, invalid := seq.Partition(orders, Order.IsValid)
+//	go deadLetter(invalid)
+//	for order := range valid {
+//	    persist(order)
+//	}
+func Partition[T any](s iter.Seq[T], pred func(T) bool) (matched, rest iter.Seq[T]) {
+	p := &partition[T]{source: s, pred: pred}
+	return p.half(true), p.half(false)
+}
+
+// partition is the state shared by the two halves of a Partition.
+type partition[T any] struct {
+	mu     sync.Mutex
+	source iter.Seq[T]
+	pred   func(T) bool
+	next   func() (T, bool)
+	stop   func()
+	done   bool
+	queues [2][]T  // indexed by side: 1 for matched, 0 for rest
+	left   [2]bool // whether each side has been ranged over and left
+}
+
+func (p *partition[T]) half(matched bool) iter.Seq[T] {
+	side := 0
+	if matched {
+		side = 1
+	}
+	return func(yield func(T) bool) {
+		defer p.leave(side)
+		for {
+			v, ok := p.take(side)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// take returns the next value for side, pulling from the source and queueing values for
+// the other side until one for side arrives.
+func (p *partition[T]) take(side int) (T, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if q := p.queues[side]; len(q) > 0 {
+		v := q[0]
+		var zero T
+		q[0] = zero // release the value for the garbage collector
+		p.queues[side] = q[1:]
+		return v, true
+	}
+	if p.next == nil && !p.done {
+		p.next, p.stop = iter.Pull(p.source)
+	}
+	for !p.done {
+		v, ok := p.next()
+		if !ok {
+			p.finish()
+			break
+		}
+		if s := p.sideOf(v); s != side {
+			p.queues[s] = append(p.queues[s], v)
+			continue
+		}
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
+
+func (p *partition[T]) sideOf(v T) int {
+	if p.pred(v) {
+		return 1
+	}
+	return 0
+}
+
+// leave records that side has been ranged over, and stops the source once both sides have.
+func (p *partition[T]) leave(side int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.left[side] = true
+	if p.left[0] && p.left[1] {
+		p.finish()
+	}
+}
+
+func (p *partition[T]) finish() {
+	if p.stop != nil {
+		p.stop()
+	}
+	p.done = true
+}
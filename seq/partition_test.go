@@ -0,0 +1,127 @@
+package seq_test
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+func isEven(v int) bool { return v%2 == 0 }
+
+// naturals yields 0, 1, 2, ... forever, counting how many values were read and whether
+// the sequence was stopped.
+func naturals(reads *int, stopped *bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer func() { *stopped = true }()
+		for v := 0; ; v++ {
+			*reads++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	t.Run("splits values by predicate in order", func(t *testing.T) {
+		even, odd := seq.Partition(seq.RangeSeq(0, 7, 1), isEven)
+		if got := slices.Collect(even); !slices.Equal(got, []int{0, 2, 4, 6}) {
+			t.Errorf("expected even values, got %v", got)
+		}
+		if got := slices.Collect(odd); !slices.Equal(got, []int{1, 3, 5}) {
+			t.Errorf("expected odd values, got %v", got)
+		}
+	})
+
+	t.Run("reads the source once whichever half is consumed first", func(t *testing.T) {
+		passes := 0
+		source := func(yield func(int) bool) {
+			passes++
+			for _, v := range []int{1, 2, 3, 4} {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+		even, odd := seq.Partition(source, isEven)
+		gotOdd, gotEven := slices.Collect(odd), slices.Collect(even)
+		if passes != 1 || !slices.Equal(gotOdd, []int{1, 3}) || !slices.Equal(gotEven, []int{2, 4}) {
+			t.Errorf("expected one pass, got %d passes and %v / %v", passes, gotEven, gotOdd)
+		}
+	})
+
+	t.Run("consumes halves of an infinite source interleaved", func(t *testing.T) {
+		reads, stopped := 0, false
+		even, odd := seq.Partition(naturals(&reads, &stopped), isEven)
+		nextEven, stopEven := iter.Pull(even)
+		nextOdd, stopOdd := iter.Pull(odd)
+
+		var got []int
+		for range 3 {
+			e, _ := nextEven()
+			o, _ := nextOdd()
+			got = append(got, e, o)
+		}
+		if !slices.Equal(got, []int{0, 1, 2, 3, 4, 5}) {
+			t.Errorf("expected interleaved values, got %v", got)
+		}
+		if reads != 6 {
+			t.Errorf("expected only the consumed values to be read, got %d reads", reads)
+		}
+
+		stopEven()
+		if stopped {
+			t.Error("source stopped while a half was still being consumed")
+		}
+		stopOdd()
+		if !stopped {
+			t.Error("expected source to stop once both halves were left")
+		}
+	})
+
+	t.Run("keeps queued values after the source is stopped", func(t *testing.T) {
+		reads, stopped := 0, false
+		even, odd := seq.Partition(naturals(&reads, &stopped), isEven)
+		for v := range even {
+			if v == 4 {
+				break
+			}
+		}
+		for range odd {
+			break
+		}
+		if !stopped {
+			t.Fatal("expected source to stop once both halves were left")
+		}
+		if got := slices.Collect(odd); !slices.Equal(got, []int{3}) {
+			t.Errorf("expected the queued odd value, got %v", got)
+		}
+		if got := slices.Collect(even); len(got) != 0 {
+			t.Errorf("expected nothing more once the source stopped, got %v", got)
+		}
+	})
+
+	t.Run("supports halves consumed from different goroutines", func(t *testing.T) {
+		even, odd := seq.Partition(seq.RangeSeq(0, 1000, 1), isEven)
+		var evens, odds []int
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() { defer wg.Done(); evens = slices.Collect(even) }()
+		go func() { defer wg.Done(); odds = slices.Collect(odd) }()
+		wg.Wait()
+
+		if len(evens) != 500 || len(odds) != 500 || evens[499] != 998 || odds[499] != 999 {
+			t.Errorf("expected 500 values per half in order, got %d / %d", len(evens), len(odds))
+		}
+	})
+
+	t.Run("yields nothing for empty input", func(t *testing.T) {
+		matched, rest := seq.Partition(seq.RangeSeq(0, 0, 1), func(int) bool { return true })
+		if len(slices.Collect(matched))+len(slices.Collect(rest)) != 0 {
+			t.Error("expected both halves to be empty")
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package seq
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Limiter is a token bucket: it holds up to burst tokens, gains one token every interval,
+// and each Wait spends one. It is safe for concurrent use, so one Limiter can enforce a
+// quota shared by several sequences or goroutines.
+type Limiter struct {
+	mu     sync.Mutex
+	every  time.Duration
+	burst  int
+	tokens float64
+	last   time.Time
+	clock  clock.Clock
+}
+
+// NewLimiter creates a Limiter that starts full and allows one event every interval,
+// with bursts of up to burst events. A burst below 1 is treated as 1, and a non-positive
+// every never waits. A nil Clock uses clock.System.
+//
+// Example:
+//
+//	limiter := seq.NewLimiter(100*time.Millisecond, 5, nil) // 10 per second, bursts of 5
+func NewLimiter(every time.Duration, burst int, c clock.Clock) *Limiter {
+	burst = max(burst, 1)
+	c = clock.OrSystem(c)
+	return &Limiter{every: every, burst: burst, tokens: float64(burst), last: c.Now(), clock: c}
+}
+
+// Wait blocks the calling goroutine until a token is available and spends it. It cannot
+// be cancelled; use WaitContext when the caller may need to give up.
+func (l *Limiter) Wait() {
+	if delay := l.reserve(); delay > 0 {
+		l.clock.Sleep(delay)
+	}
+}
+
+// WaitContext is like Wait, but returns ctx.Err() as soon as ctx is done. A token spent
+// by a cancelled wait is given back to the limiter.
+func (l *Limiter) WaitContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-l.clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// reserve spends a token, possibly going into debt, and returns how long the caller
+// must wait for the token it took to have been earned.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.every <= 0 {
+		return 0
+	}
+	now := l.clock.Now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(float64(l.burst), l.tokens+float64(elapsed)/float64(l.every))
+	}
+	l.last = now // a clock moved backwards restarts the refill from now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.every))
+}
+
+// RateLimit yields the values of s no faster than limiter allows, waiting for a token
+// before each value, so downstream stages that call rate-limited APIs respect the quota
+// without limiting every call themselves.
+//
+// The wait happens in the goroutine ranging over the sequence and, like Limiter.Wait,
+// cannot be interrupted: a consumer that must stay responsive to shutdown should use
+// RateLimitContext instead.
+//
+// Example:
+//
+//	limiter := seq.NewLimiter(time.Second/20, 1, nil) // 20 requests per second
+//	for id := range seq.RateLimit(ids, limiter) {
+//	    results = append(results, fetchUser(id))
+//	}
+func RateLimit[T any](s iter.Seq[T], limiter *Limiter) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			limiter.Wait()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// RateLimitContext is the cancellable form of RateLimit. Each value of s is yielded as
+// Some once its token is available; if ctx is done first, it yields one Failure holding
+// ctx.Err() and stops without reading further values.
+//
+// Example:
+//
+//	for m := range seq.RateLimitContext(ctx, ids, limiter) {
+//	    id, err := m.OrError()
+//	    if err != nil {
+//	        return err // shutting down
+//	    }
+//	    results = append(results, fetchUser(id))
+//	}
+func RateLimitContext[T any](ctx context.Context, s iter.Seq[T], limiter *Limiter) iter.Seq[maybe.Maybe[T]] {
+	return func(yield func(maybe.Maybe[T]) bool) {
+		for v := range s {
+			if err := limiter.WaitContext(ctx); err != nil {
+				yield(maybe.Failed[T](err))
+				return
+			}
+			if !yield(maybe.Just(v)) {
+				return
+			}
+		}
+	}
+}
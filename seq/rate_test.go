@@ -0,0 +1,237 @@
+package seq_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+// waitForSleeper blocks until a goroutine is sleeping on fake.
+func waitForSleeper(t *testing.T, fake *clock.Fake) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for fake.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("consumer never waited on the clock")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// receive returns the next value from ch, failing if none arrives soon.
+func receive(t *testing.T, ch <-chan int) int {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("no value emitted")
+		return 0
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Run("emits a burst and then one value per interval", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		limiter := seq.NewLimiter(time.Second, 2, fake)
+		out := make(chan int)
+		go func() {
+			for v := range seq.RateLimit(seq.RangeSeq(1, 5, 1), limiter) {
+				out <- v
+			}
+			close(out)
+		}()
+
+		if a, b := receive(t, out), receive(t, out); a != 1 || b != 2 {
+			t.Fatalf("expected burst of 1, 2, got %d, %d", a, b)
+		}
+		for want := 3; want <= 4; want++ {
+			waitForSleeper(t, fake)
+			select {
+			case v := <-out:
+				t.Fatalf("value %d emitted before its token was earned", v)
+			default:
+			}
+			fake.Advance(time.Second)
+			if got := receive(t, out); got != want {
+				t.Errorf("expected %d, got %d", want, got)
+			}
+		}
+		if _, open := <-out; open {
+			t.Error("expected sequence to end")
+		}
+	})
+
+	t.Run("refills tokens while idle up to the burst", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		limiter := seq.NewLimiter(time.Second, 3, fake)
+		for range 3 {
+			limiter.Wait()
+		}
+		fake.Advance(time.Hour)
+
+		got := slices.Collect(seq.RateLimit(seq.RangeSeq(0, 3, 1), limiter))
+		if len(got) != 3 || fake.Waiters() != 0 {
+			t.Errorf("expected a full burst without waiting, got %v", got)
+		}
+	})
+
+	t.Run("ignores a clock moved backwards", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(100, 0))
+		limiter := seq.NewLimiter(time.Second, 1, fake)
+		fake.Set(time.Unix(0, 0))
+
+		limiter.Wait() // spends the initial token
+		done := make(chan struct{})
+		go func() { limiter.Wait(); close(done) }()
+		waitForSleeper(t, fake)
+		fake.Advance(time.Second)
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Wait did not return after the interval")
+		}
+
+		fake.Advance(time.Second)
+		limiter.Wait() // refilled one interval after the backwards move
+		if fake.Waiters() != 0 {
+			t.Error("expected a refilled token without waiting")
+		}
+	})
+
+	t.Run("never waits with a non-positive interval", func(t *testing.T) {
+		limiter := seq.NewLimiter(0, 0, clock.NewFake(time.Unix(0, 0)))
+		if got := slices.Collect(seq.RateLimit(seq.RangeSeq(0, 100, 1), limiter)); len(got) != 100 {
+			t.Errorf("expected 100 values, got %d", len(got))
+		}
+	})
+
+	t.Run("stops when the consumer stops", func(t *testing.T) {
+		limiter := seq.NewLimiter(time.Second, 5, clock.NewFake(time.Unix(0, 0)))
+		for v := range seq.RateLimit(seq.RangeSeq(0, 10, 1), limiter) {
+			if v != 0 {
+				t.Errorf("expected to stop after first value, got %d", v)
+			}
+			break
+		}
+	})
+}
+
+func TestLimiter_WaitContext(t *testing.T) {
+	t.Run("returns once the token is earned", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		limiter := seq.NewLimiter(time.Second, 1, fake)
+		if err := limiter.WaitContext(context.Background()); err != nil {
+			t.Fatalf("expected the initial token, got %v", err)
+		}
+
+		done := make(chan error)
+		go func() { done <- limiter.WaitContext(context.Background()) }()
+		waitForSleeper(t, fake)
+		fake.Advance(time.Second)
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("expected nil, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("WaitContext did not return after the interval")
+		}
+	})
+
+	t.Run("gives up and returns the token when cancelled", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		limiter := seq.NewLimiter(time.Second, 1, fake)
+		limiter.Wait()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- limiter.WaitContext(ctx) }()
+		waitForSleeper(t, fake)
+		cancel()
+		select {
+		case err := <-done:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("expected context.Canceled, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("WaitContext ignored the cancellation")
+		}
+
+		fake.Advance(time.Second)
+		go func() { done <- limiter.WaitContext(context.Background()) }()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("expected the refunded token to be available without waiting")
+		}
+	})
+
+	t.Run("fails at once for a done context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		limiter := seq.NewLimiter(time.Second, 1, clock.NewFake(time.Unix(0, 0)))
+		if err := limiter.WaitContext(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if err := limiter.WaitContext(context.Background()); err != nil {
+			t.Errorf("expected the token to be unspent, got %v", err)
+		}
+	})
+}
+
+func TestRateLimitContext(t *testing.T) {
+	t.Run("yields values as Some", func(t *testing.T) {
+		limiter := seq.NewLimiter(time.Second, 3, clock.NewFake(time.Unix(0, 0)))
+		var got []int
+		for m := range seq.RateLimitContext(context.Background(), seq.RangeSeq(0, 3, 1), limiter) {
+			got = append(got, m.OrElseDefault(-1))
+		}
+		if !slices.Equal(got, []int{0, 1, 2}) {
+			t.Errorf("expected [0 1 2], got %v", got)
+		}
+	})
+
+	t.Run("ends with a Failure when the context is done", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		limiter := seq.NewLimiter(time.Second, 1, fake)
+		ctx, cancel := context.WithCancel(context.Background())
+		out := make(chan maybe.Maybe[int])
+		go func() {
+			for m := range seq.RateLimitContext(ctx, seq.RangeSeq(0, 10, 1), limiter) {
+				out <- m
+			}
+			close(out)
+		}()
+
+		if v, _, _ := (<-out).Get(); v != 0 {
+			t.Fatalf("expected first value 0, got %d", v)
+		}
+		waitForSleeper(t, fake)
+		cancel()
+		if _, _, err := (<-out).Get(); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected Failure with context.Canceled, got %v", err)
+		}
+		if _, open := <-out; open {
+			t.Error("expected sequence to end after the Failure")
+		}
+	})
+
+	t.Run("stops when the consumer stops", func(t *testing.T) {
+		limiter := seq.NewLimiter(time.Second, 5, clock.NewFake(time.Unix(0, 0)))
+		count := 0
+		for range seq.RateLimitContext(context.Background(), seq.RangeSeq(0, 10, 1), limiter) {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("expected one value, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package seq
+
+import (
+	"iter"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/randsrc"
+)
+
+// Sample yields each value of s with probability p, drawing from src, for sampled logging
+// or tracing branches that should see only a fraction of a pipeline's values. It decides
+// like maybe.KeepIfSampledFrom: p <= 0 yields nothing, p >= 1 yields everything without
+// drawing, and a nil src uses randsrc.Default.
+//
+// Example:
+//
+//	for req := range seq.Sample(requests, 0.01, nil) {
+//	    tracer.Record(req) // roughly 1% of requests
+//	}
+func Sample[T any](s iter.Seq[T], p float64, src randsrc.Source) iter.Seq[T] {
+	keep := maybe.KeepIfSampledFrom[T](src, p)
+	return func(yield func(T) bool) {
+		for v := range s {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
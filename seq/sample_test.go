@@ -0,0 +1,49 @@
+package seq_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/randsrc"
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+func TestSample(t *testing.T) {
+	t.Run("keeps about a fraction p of the values", func(t *testing.T) {
+		got := slices.Collect(seq.Sample(seq.RangeSeq(0, 10_000, 1), 0.1, randsrc.New(42)))
+		if len(got) < 800 || len(got) > 1200 {
+			t.Errorf("expected about 1000 values, got %d", len(got))
+		}
+		if !slices.IsSorted(got) {
+			t.Error("expected sampled values in source order")
+		}
+	})
+
+	t.Run("is reproducible with a seeded source", func(t *testing.T) {
+		a := slices.Collect(seq.Sample(seq.RangeSeq(0, 100, 1), 0.5, randsrc.New(7)))
+		b := slices.Collect(seq.Sample(seq.RangeSeq(0, 100, 1), 0.5, randsrc.New(7)))
+		if !slices.Equal(a, b) {
+			t.Error("expected the same sample from the same seed")
+		}
+	})
+
+	t.Run("keeps everything or nothing at the bounds", func(t *testing.T) {
+		if got := slices.Collect(seq.Sample(seq.RangeSeq(0, 10, 1), 1, nil)); len(got) != 10 {
+			t.Errorf("expected all values for p >= 1, got %v", got)
+		}
+		if got := slices.Collect(seq.Sample(seq.RangeSeq(0, 10, 1), 0, nil)); len(got) != 0 {
+			t.Errorf("expected no values for p <= 0, got %v", got)
+		}
+	})
+
+	t.Run("stops when the consumer stops", func(t *testing.T) {
+		count := 0
+		for range seq.Sample(seq.RangeSeq(0, 10, 1), 1, nil) {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("expected one value, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package seq
+
+import "iter"
+
+// Range returns the integers from from (inclusive) to to (exclusive), advancing by step.
+//
+// Behavior:
+//   - A positive step counts up while the value is below to
+//   - A negative step counts down while the value is above to
+//   - A zero step, or a step pointing away from to, returns an empty slice
+//
+// Example:
+//
+//	seq.Range(0, 10, 3)  // [0 3 6 9]
+//	seq.Range(5, 0, -2)  // [5 3 1]
+func Range(from, to, step int) []int {
+	out := []int{}
+	for v := range RangeSeq(from, to, step) {
+		out = append(out, v)
+	}
+	return out
+}
+
+// RangeSeq is the lazy form of Range, yielding the same values without allocating a slice.
+// It stops before the counter would pass to, so bounds near math.MaxInt or math.MinInt
+// never wrap around.
+//
+// Example:
+//
+//	for id := range seq.RangeSeq(1, 1_000_000, 1) {
+//	    process(id)
+//	}
+func RangeSeq(from, to, step int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		switch {
+		case step > 0:
+			for v := from; v < to; v += step {
+				// The distance is computed unsigned, which cannot overflow since v < to.
+				if !yield(v) || uint(to)-uint(v) <= uint(step) {
+					return
+				}
+			}
+		case step < 0:
+			for v := from; v > to; v += step {
+				if !yield(v) || uint(v)-uint(to) <= -uint(step) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Repeat returns a slice holding n copies of v. A non-positive n returns an empty slice.
+//
+// Example:
+//
+//	seq.Repeat("x", 3) // ["x" "x" "x"]
+func Repeat[T any](v T, n int) []T {
+	return Times(n, func(int) T { return v })
+}
+
+// Times returns the results of calling fn with 0, 1, ..., n-1.
+// A non-positive n returns an empty slice.
+//
+// Example:
+//
+//	users := seq.Times(3, func(i int) User {
+//	    return User{ID: i, Name: fmt.Sprintf("user-%d", i)}
+//	})
+func Times[T any](n int, fn func(i int) T) []T {
+	out := make([]T, 0, max(n, 0))
+	for i := 0; i < n; i++ {
+		out = append(out, fn(i))
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package seq_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+func TestRange(t *testing.T) {
+	cases := []struct {
+		name           string
+		from, to, step int
+		want           []int
+	}{
+		{"counts up", 0, 10, 3, []int{0, 3, 6, 9}},
+		{"counts down", 5, 0, -2, []int{5, 3, 1}},
+		{"excludes end", 1, 3, 1, []int{1, 2}},
+		{"empty when from equals to", 4, 4, 1, []int{}},
+		{"empty for zero step", 0, 10, 0, []int{}},
+		{"empty when step points away", 0, 10, -1, []int{}},
+		{"stops before overflowing near MaxInt", math.MaxInt - 5, math.MaxInt, 2, []int{math.MaxInt - 5, math.MaxInt - 3, math.MaxInt - 1}},
+		{"stops before overflowing near MinInt", math.MinInt + 5, math.MinInt, -2, []int{math.MinInt + 5, math.MinInt + 3, math.MinInt + 1}},
+		{"spans the whole int range", math.MinInt, math.MaxInt, math.MaxInt, []int{math.MinInt, -1, math.MaxInt - 1}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := seq.Range(c.from, c.to, c.step)
+			if got == nil || !slices.Equal(got, c.want) {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestRangeSeq(t *testing.T) {
+	t.Run("yields lazily", func(t *testing.T) {
+		got := slices.Collect(seq.RangeSeq(0, 5, 1))
+		if !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("stops when consumer breaks", func(t *testing.T) {
+		for _, step := range []int{1, -1} {
+			var got []int
+			for v := range seq.RangeSeq(0, 100*step, step) {
+				got = append(got, v)
+				if len(got) == 2 {
+					break
+				}
+			}
+			if !slices.Equal(got, []int{0, step}) {
+				t.Errorf("step %d: expected early stop, got %v", step, got)
+			}
+		}
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	t.Run("repeats value", func(t *testing.T) {
+		if got := seq.Repeat("x", 3); !slices.Equal(got, []string{"x", "x", "x"}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("returns empty slice for non-positive n", func(t *testing.T) {
+		if got := seq.Repeat(1, -2); got == nil || len(got) != 0 {
+			t.Errorf("expected empty slice, got %v", got)
+		}
+	})
+}
+
+func TestTimes(t *testing.T) {
+	t.Run("calls fn with each index", func(t *testing.T) {
+		got := seq.Times(4, func(i int) int { return i * i })
+		if !slices.Equal(got, []int{0, 1, 4, 9}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("does not call fn for zero n", func(t *testing.T) {
+		got := seq.Times(0, func(i int) int { t.Error("fn should not be called"); return i })
+		if len(got) != 0 {
+			t.Errorf("expected empty slice, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package seq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// ErrStalled is wrapped by the Failure TimeoutBetween yields when the source is too slow.
+var ErrStalled = errors.New("seq: no element within timeout")
+
+// TimeoutBetween protects a consumer from a stalled source: each value of s is yielded as
+// Some, and if no value arrives within d of the previous one (or of the start, for the
+// first), it yields one Failure wrapping ErrStalled and stops. A non-positive d never
+// times out. A nil Clock uses clock.System.
+//
+// Behavior:
+//   - s runs in its own goroutine feeding the consumer, so a blocked source cannot block it
+//   - A panic in s is yielded as a Failure and ends the sequence
+//   - When the consumer stops or times out, the goroutine exits at the source's next value;
+//     a source that never produces one keeps its goroutine until it does
+//
+// Example:
+//
+//	for m := range seq.TimeoutBetween(ticks, 30*time.Second, nil) {
+//	    tick, err := m.OrError()
+//	    if err != nil {
+//	        return err // the feed stalled; reconnect
+//	    }
+//	    handle(tick)
+//	}
+func TimeoutBetween[T any](s iter.Seq[T], d time.Duration, c clock.Clock) iter.Seq[maybe.Maybe[T]] {
+	c = clock.OrSystem(c)
+	return func(yield func(maybe.Maybe[T]) bool) {
+		values := make(chan maybe.Maybe[T])
+		done := make(chan struct{})
+		defer close(done)
+		go feed(s, values, done)
+
+		for {
+			var timeout <-chan time.Time
+			if d > 0 {
+				timeout = c.After(d)
+			}
+			select {
+			case m, ok := <-values:
+				if !ok || !yield(m) || m.IsFailed() {
+					return
+				}
+			case <-timeout:
+				yield(maybe.Failed[T](fmt.Errorf("%w: waited %v", ErrStalled, d)))
+				return
+			}
+		}
+	}
+}
+
+// feed sends the values of s to values until s ends or done is closed, then closes values.
+// A panic in s is sent as a Failure.
+func feed[T any](s iter.Seq[T], values chan<- maybe.Maybe[T], done <-chan struct{}) {
+	defer close(values)
+	send := func(m maybe.Maybe[T]) bool {
+		select {
+		case values <- m:
+			return true
+		case <-done:
+			return false
+		}
+	}
+	result := maybe.Do(func() maybe.Maybe[struct{}] {
+		for v := range s {
+			if !send(maybe.Just(v)) {
+				break
+			}
+		}
+		return maybe.Just(struct{}{})
+	})
+	if _, _, err := result.Get(); err != nil {
+		send(maybe.Failed[T](err))
+	}
+}
@@ -0,0 +1,123 @@
+package seq_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lonelywolflee/lw-project-fp-go/clock"
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+// waitForTimers blocks until n timers are pending on fake.
+func waitForTimers(t *testing.T, fake *clock.Fake, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for fake.Waiters() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d pending timers, got %d", n, fake.Waiters())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// stalling yields first and then blocks until release is closed.
+func stalling(first int, release <-chan struct{}) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		if yield(first) {
+			<-release
+		}
+	}
+}
+
+func TestTimeoutBetween(t *testing.T) {
+	t.Run("yields every value of a timely source", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		var got []int
+		for m := range seq.TimeoutBetween(seq.RangeSeq(0, 3, 1), time.Second, fake) {
+			got = append(got, m.OrElseDefault(-1))
+		}
+		if !slices.Equal(got, []int{0, 1, 2}) {
+			t.Errorf("expected [0 1 2], got %v", got)
+		}
+	})
+
+	t.Run("yields a Failure and stops when the source stalls", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		release := make(chan struct{})
+		defer close(release)
+		out := make(chan maybe.Maybe[int])
+		go func() {
+			for m := range seq.TimeoutBetween(stalling(7, release), time.Second, fake) {
+				out <- m
+			}
+			close(out)
+		}()
+
+		if v, _, _ := (<-out).Get(); v != 7 {
+			t.Fatalf("expected first value 7, got %d", v)
+		}
+		waitForTimers(t, fake, 2)
+		fake.Advance(time.Second)
+		if _, _, err := (<-out).Get(); !errors.Is(err, seq.ErrStalled) {
+			t.Errorf("expected Failure wrapping ErrStalled, got %v", err)
+		}
+		if _, open := <-out; open {
+			t.Error("expected sequence to end after the Failure")
+		}
+	})
+
+	t.Run("uses the system clock when none is given", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		var got []maybe.Maybe[int]
+		for m := range seq.TimeoutBetween(stalling(1, release), 10*time.Millisecond, nil) {
+			got = append(got, m)
+		}
+		if len(got) != 2 || !got[0].IsSome() || !got[1].ErrIs(seq.ErrStalled) {
+			t.Errorf("expected Some then a stalled Failure, got %v", got)
+		}
+	})
+
+	t.Run("never times out with a non-positive timeout", func(t *testing.T) {
+		fake := clock.NewFake(time.Unix(0, 0))
+		got := slices.Collect(seq.TimeoutBetween(seq.RangeSeq(0, 5, 1), 0, fake))
+		if len(got) != 5 || fake.Waiters() != 0 {
+			t.Errorf("expected 5 values without timers, got %v", got)
+		}
+	})
+
+	t.Run("converts a panicking source to Failure", func(t *testing.T) {
+		source := func(yield func(int) bool) {
+			yield(1)
+			panic("feed broken")
+		}
+		got := slices.Collect(seq.TimeoutBetween(source, time.Second, clock.NewFake(time.Unix(0, 0))))
+		if len(got) != 2 {
+			t.Fatalf("expected a value and a Failure, got %v", got)
+		}
+		if _, _, err := got[1].Get(); err == nil || !strings.Contains(err.Error(), "feed broken") {
+			t.Errorf("expected Failure with the panic, got %v", err)
+		}
+	})
+
+	t.Run("stops the source when the consumer stops", func(t *testing.T) {
+		stopped := make(chan struct{})
+		source := func(yield func(int) bool) {
+			defer close(stopped)
+			for v := 0; yield(v); v++ {
+			}
+		}
+		for range seq.TimeoutBetween(source, time.Second, clock.NewFake(time.Unix(0, 0))) {
+			break
+		}
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Error("source kept running after the consumer stopped")
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package seq
+
+import (
+	"iter"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// TakeWhileInclusive yields the values of s while pred holds, and then the first value for
+// which it does not, so a marker that ends a section is kept ("everything up to and
+// including the marker"). It stops reading s after that value.
+//
+// Example:
+//
+//	section := seq.TakeWhileInclusive(lines, func(l string) bool { return l != "END" })
+//	// "a", "b", "END" from "a", "b", "END", "c"
+func TakeWhileInclusive[T any](s iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if !yield(v) || !pred(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeUntilFailure yields the values of the Some elements of s until the first None or
+// Failure, for ingestion jobs that must stop at the first corrupt record but keep the work
+// done before it. It is the lazy form of maybe.CollectWhile: after ranging, result reports
+// how many values were yielded and the Failure's error, or nil if s ended or reached a None.
+//
+// Each range over the sequence reads s afresh and resets what result reports.
+//
+// Example:
+//
+//	rows, result := seq.TakeUntilFailure(decodeRecords(file))
+//	for row := range rows {
+//	    insert(row)
+//	}
+//	if n, err := result(); err != nil {
+//	    log.Printf("stopped after %d rows: %v", n, err)
+//	}
+func TakeUntilFailure[T any](s iter.Seq[maybe.Maybe[T]]) (values iter.Seq[T], result func() (int, error)) {
+	var count int
+	var stopErr error
+	values = func(yield func(T) bool) {
+		count, stopErr = 0, nil
+		for m := range s {
+			v, ok, err := m.Get()
+			if !ok {
+				stopErr = err
+				return
+			}
+			count++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return values, func() (int, error) { return count, stopErr }
+}
+
+// DropWhile skips the values of s while pred holds and yields every value from the first
+// one for which it does not, without testing pred again.
+//
+// Example:
+//
+//	body := seq.DropWhile(lines, func(l string) bool { return strings.HasPrefix(l, "#") })
+//	// skips the leading comment lines only
+func DropWhile[T any](s iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range s {
+			if dropping && pred(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SkipUntil skips the values of s until pred holds and yields every value from that one on,
+// so the marker is included. It is DropWhile with the predicate negated.
+//
+// Example:
+//
+//	fromDeploy := seq.SkipUntil(events, func(e Event) bool { return e.Kind == "deploy" })
+//	// the first deploy event and everything after it
+func SkipUntil[T any](s iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return DropWhile(s, func(v T) bool { return !pred(v) })
+}
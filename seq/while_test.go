@@ -0,0 +1,142 @@
+package seq_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/seq"
+)
+
+func isNotEnd(s string) bool { return s != "END" }
+
+func TestTakeWhileInclusive(t *testing.T) {
+	t.Run("keeps the first value that fails pred", func(t *testing.T) {
+		got := slices.Collect(seq.TakeWhileInclusive(slices.Values([]string{"a", "b", "END", "c"}), isNotEnd))
+		if !slices.Equal(got, []string{"a", "b", "END"}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("yields everything when pred always holds", func(t *testing.T) {
+		got := slices.Collect(seq.TakeWhileInclusive(slices.Values([]string{"a", "b"}), isNotEnd))
+		if !slices.Equal(got, []string{"a", "b"}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("stops reading the source after the marker", func(t *testing.T) {
+		got := slices.Collect(seq.TakeWhileInclusive(seq.RangeSeq(0, 1_000_000, 1), func(v int) bool { return v < 2 }))
+		if !slices.Equal(got, []int{0, 1, 2}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("stops when the consumer stops", func(t *testing.T) {
+		for v := range seq.TakeWhileInclusive(slices.Values([]string{"a", "b", "END"}), isNotEnd) {
+			if v != "a" {
+				t.Errorf("expected to stop after first value, got %q", v)
+			}
+			break
+		}
+	})
+}
+
+func TestDropWhile(t *testing.T) {
+	t.Run("skips leading values only", func(t *testing.T) {
+		got := slices.Collect(seq.DropWhile(seq.RangeSeq(0, 6, 1), func(v int) bool { return v%3 != 2 }))
+		if !slices.Equal(got, []int{2, 3, 4, 5}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+
+	t.Run("yields nothing when pred always holds", func(t *testing.T) {
+		got := slices.Collect(seq.DropWhile(seq.RangeSeq(0, 3, 1), func(int) bool { return true }))
+		if len(got) != 0 {
+			t.Errorf("expected no values, got %v", got)
+		}
+	})
+
+	t.Run("stops when the consumer stops", func(t *testing.T) {
+		var got []int
+		for v := range seq.DropWhile(seq.RangeSeq(0, 10, 1), func(v int) bool { return v < 5 }) {
+			got = append(got, v)
+			if len(got) == 2 {
+				break
+			}
+		}
+		if !slices.Equal(got, []int{5, 6}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+}
+
+func TestSkipUntil(t *testing.T) {
+	t.Run("yields from the marker on", func(t *testing.T) {
+		got := slices.Collect(seq.SkipUntil(slices.Values([]string{"a", "END", "b", "END"}), func(s string) bool { return !isNotEnd(s) }))
+		if !slices.Equal(got, []string{"END", "b", "END"}) {
+			t.Errorf("unexpected values %v", got)
+		}
+	})
+}
+
+func TestTakeUntilFailure(t *testing.T) {
+	t.Run("stops at the first Failure and reports it", func(t *testing.T) {
+		err := errors.New("corrupt record")
+		records := slices.Values([]maybe.Maybe[int]{maybe.Just(1), maybe.Just(2), maybe.Failed[int](err), maybe.Just(3)})
+		values, result := seq.TakeUntilFailure(records)
+
+		if got := slices.Collect(values); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("expected [1 2], got %v", got)
+		}
+		if n, got := result(); n != 2 || got != err {
+			t.Errorf("expected 2 and the Failure's error, got %d and %v", n, got)
+		}
+	})
+
+	t.Run("stops at the first None without an error", func(t *testing.T) {
+		records := slices.Values([]maybe.Maybe[int]{maybe.Just(1), maybe.Empty[int](), maybe.Just(2)})
+		values, result := seq.TakeUntilFailure(records)
+
+		if got := slices.Collect(values); !slices.Equal(got, []int{1}) {
+			t.Errorf("expected [1], got %v", got)
+		}
+		if n, err := result(); n != 1 || err != nil {
+			t.Errorf("expected 1 and nil, got %d and %v", n, err)
+		}
+	})
+
+	t.Run("counts every value of a source without stops", func(t *testing.T) {
+		records := slices.Values([]maybe.Maybe[string]{maybe.Just("a"), maybe.Just("b")})
+		values, result := seq.TakeUntilFailure(records)
+		for range values {
+		}
+		if n, err := result(); n != 2 || err != nil {
+			t.Errorf("expected 2 and nil, got %d and %v", n, err)
+		}
+	})
+
+	t.Run("counts only the values yielded before the consumer stops", func(t *testing.T) {
+		records := slices.Values([]maybe.Maybe[int]{maybe.Just(1), maybe.Just(2), maybe.Just(3)})
+		values, result := seq.TakeUntilFailure(records)
+		for range values {
+			break
+		}
+		if n, err := result(); n != 1 || err != nil {
+			t.Errorf("expected 1 and nil, got %d and %v", n, err)
+		}
+	})
+
+	t.Run("resets the result on each range", func(t *testing.T) {
+		records := slices.Values([]maybe.Maybe[int]{maybe.Just(1), maybe.Failed[int](errors.New("bad"))})
+		values, result := seq.TakeUntilFailure(records)
+		for range 2 {
+			for range values {
+			}
+		}
+		if n, _ := result(); n != 1 {
+			t.Errorf("expected 1 after a second range, got %d", n)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package sqlfp_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeDriver is a minimal database/sql driver for exercising sqlfp without a real database.
+type fakeDriver struct{}
+
+// fakeState records what happened on a fake database and controls its failures.
+type fakeState struct {
+	mu          sync.Mutex
+	commits     int
+	rollbacks   int
+	beginErr    error
+	commitErr   error
+	rollbackErr error
+	queryErr    error
+	rowsErr     error
+	rows        [][]driver.Value
+	closedRows  int
+}
+
+var (
+	fakeStates sync.Map
+	fakeSeq    atomic.Int64
+)
+
+func init() {
+	sql.Register("sqlfp-fake", fakeDriver{})
+}
+
+// openFake opens a fresh fake database and returns it with its state.
+func openFake(t *testing.T) (*sql.DB, *fakeState) {
+	t.Helper()
+	name := strconv.FormatInt(fakeSeq.Add(1), 10)
+	state := &fakeState{}
+	fakeStates.Store(name, state)
+
+	db, err := sql.Open("sqlfp-fake", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, state
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	state, ok := fakeStates.Load(name)
+	if !ok {
+		return nil, errors.New("unknown fake database")
+	}
+	return &fakeConn{state: state.(*fakeState)}, nil
+}
+
+type fakeConn struct {
+	state *fakeState
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{state: c.state}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.state.beginErr != nil {
+		return nil, c.state.beginErr
+	}
+	return &fakeTx{state: c.state}, nil
+}
+
+type fakeTx struct {
+	state *fakeState
+}
+
+func (tx *fakeTx) Commit() error {
+	tx.state.mu.Lock()
+	defer tx.state.mu.Unlock()
+	tx.state.commits++
+	return tx.state.commitErr
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.state.mu.Lock()
+	defer tx.state.mu.Unlock()
+	tx.state.rollbacks++
+	return tx.state.rollbackErr
+}
+
+type fakeStmt struct {
+	state *fakeState
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.state.queryErr != nil {
+		return nil, s.state.queryErr
+	}
+	return &fakeRows{state: s.state}, nil
+}
+
+type fakeRows struct {
+	state *fakeState
+	pos   int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+
+func (r *fakeRows) Close() error {
+	r.state.mu.Lock()
+	defer r.state.mu.Unlock()
+	r.state.closedRows++
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.state.rows) {
+		if r.state.rowsErr != nil {
+			return r.state.rowsErr
+		}
+		return io.EOF
+	}
+	copy(dest, r.state.rows[r.pos])
+	r.pos++
+	return nil
+}
@@ -0,0 +1,132 @@
+package sqlfp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"iter"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// Querier is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Scanner is implemented by *sql.Rows and *sql.Row, so scan functions can be shared between them.
+type Scanner interface {
+	Scan(dest ...any) error
+}
+
+// QueryOne runs the query and scans the first row into a Maybe.
+//
+// Behavior:
+//   - If the query returns at least one row: returns Just(scan(row)); remaining rows are ignored
+//   - If the query returns no rows, or scan returns sql.ErrNoRows: returns None
+//   - If the query, scan, or row iteration fails: returns Failure with the error
+//   - If scan panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	user := sqlfp.QueryOne(ctx, db, "SELECT id, name FROM users WHERE id = ?", scanUser, id)
+//	// Just(user), Empty[User]() if not found, or Failed[User](err)
+func QueryOne[T any](ctx context.Context, q Querier, query string, scan func(Scanner) (T, error), args ...any) maybe.Maybe[T] {
+	return maybe.Do(func() maybe.Maybe[T] {
+		rows, err := q.QueryContext(ctx, query, args...)
+		if err != nil {
+			return maybe.Failed[T](err)
+		}
+		defer rows.Close()
+
+		if !rows.Next() {
+			if err := rows.Err(); err != nil {
+				return maybe.Failed[T](err)
+			}
+			return maybe.Empty[T]()
+		}
+		v, err := scan(rows)
+		if errors.Is(err, sql.ErrNoRows) {
+			return maybe.Empty[T]()
+		}
+		return maybe.ToMaybe(v, err)
+	})
+}
+
+// QueryAll runs the query and scans every row into a slice.
+//
+// Behavior:
+//   - If every row scans successfully: returns Just(values); an empty result is Just([]T{})
+//   - If the query, any scan, or row iteration fails: returns Failure with the first error
+//   - If scan panics: returns Failure with the panic converted to an error
+//
+// Example:
+//
+//	users := sqlfp.QueryAll(ctx, db, "SELECT id, name FROM users", scanUser)
+//	// Just([]User{...}) or Failed[[]User](err)
+func QueryAll[T any](ctx context.Context, q Querier, query string, scan func(Scanner) (T, error), args ...any) maybe.Maybe[[]T] {
+	return maybe.Do(func() maybe.Maybe[[]T] {
+		rows, err := q.QueryContext(ctx, query, args...)
+		if err != nil {
+			return maybe.Failed[[]T](err)
+		}
+		defer rows.Close()
+
+		values := []T{}
+		for rows.Next() {
+			v, err := scan(rows)
+			if err != nil {
+				return maybe.Failed[[]T](err)
+			}
+			values = append(values, v)
+		}
+		if err := rows.Err(); err != nil {
+			return maybe.Failed[[]T](err)
+		}
+		return maybe.Just(values)
+	})
+}
+
+// QueryStream returns a lazy sequence over the query results.
+// The query is executed when iteration starts, and the rows are closed when
+// iteration finishes or the consumer stops early.
+//
+// Behavior:
+//   - Each successfully scanned row is yielded as Just(value)
+//   - If the query, a scan, or row iteration fails: yields a single Failure and stops
+//   - If scan panics: yields a Failure with the panic converted to an error and stops
+//
+// Example:
+//
+//	for m := range sqlfp.QueryStream(ctx, db, "SELECT id, name FROM users", scanUser) {
+//	    user, err := m.OrError()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    process(user)
+//	}
+func QueryStream[T any](ctx context.Context, q Querier, query string, scan func(Scanner) (T, error), args ...any) iter.Seq[maybe.Maybe[T]] {
+	return func(yield func(maybe.Maybe[T]) bool) {
+		rows, err := q.QueryContext(ctx, query, args...)
+		if err != nil {
+			yield(maybe.Failed[T](err))
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			m := maybe.Try(func() (T, error) {
+				return scan(rows)
+			})
+			if !yield(m) {
+				return
+			}
+			if _, _, err := m.Get(); err != nil {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(maybe.Failed[T](err))
+		}
+	}
+}
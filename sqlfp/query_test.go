@@ -0,0 +1,281 @@
+package sqlfp_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/sqlfp"
+)
+
+func scanInt(s sqlfp.Scanner) (int, error) {
+	var v int
+	err := s.Scan(&v)
+	return v, err
+}
+
+func intRows(values ...int64) [][]driver.Value {
+	rows := make([][]driver.Value, len(values))
+	for i, v := range values {
+		rows[i] = []driver.Value{v}
+	}
+	return rows
+}
+
+func TestQueryOne(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns Some with the first row", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(7, 8)
+
+		value, ok, err := sqlfp.QueryOne(ctx, db, "SELECT", scanInt).Get()
+		if err != nil || !ok || value != 7 {
+			t.Errorf("expected Just(7), got %v, %v, %v", value, ok, err)
+		}
+	})
+
+	t.Run("returns None when there are no rows", func(t *testing.T) {
+		db, _ := openFake(t)
+
+		if _, ok := sqlfp.QueryOne(ctx, db, "SELECT", scanInt).(maybe.None[int]); !ok {
+			t.Fatal("expected None for empty result")
+		}
+	})
+
+	t.Run("returns None when scan reports sql.ErrNoRows", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1)
+		scan := func(sqlfp.Scanner) (int, error) { return 0, sql.ErrNoRows }
+
+		if _, ok := sqlfp.QueryOne(ctx, db, "SELECT", scan).(maybe.None[int]); !ok {
+			t.Fatal("expected None when scan returns sql.ErrNoRows")
+		}
+	})
+
+	t.Run("returns Failure when query fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.queryErr = errors.New("query failed")
+
+		_, _, err := sqlfp.QueryOne(ctx, db, "SELECT", scanInt).Get()
+		if !errors.Is(err, state.queryErr) {
+			t.Errorf("expected query error, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure when row iteration fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rowsErr = errors.New("connection lost")
+
+		_, _, err := sqlfp.QueryOne(ctx, db, "SELECT", scanInt).Get()
+		if !errors.Is(err, state.rowsErr) {
+			t.Errorf("expected rows error, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure when scan fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1)
+		scanErr := errors.New("bad column")
+		scan := func(sqlfp.Scanner) (int, error) { return 0, scanErr }
+
+		_, _, err := sqlfp.QueryOne(ctx, db, "SELECT", scan).Get()
+		if !errors.Is(err, scanErr) {
+			t.Errorf("expected scan error, got %v", err)
+		}
+	})
+
+	t.Run("converts scan panic to Failure", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1)
+		scan := func(sqlfp.Scanner) (int, error) { panic("scan panic") }
+
+		if _, ok := sqlfp.QueryOne(ctx, db, "SELECT", scan).(maybe.Failure[int]); !ok {
+			t.Fatal("expected Failure when scan panics")
+		}
+		if state.closedRows != 1 {
+			t.Errorf("expected rows to be closed, got %d closes", state.closedRows)
+		}
+	})
+}
+
+func TestQueryAll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns Some with all rows", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1, 2, 3)
+
+		values, ok, err := sqlfp.QueryAll(ctx, db, "SELECT", scanInt).Get()
+		if err != nil || !ok {
+			t.Fatalf("expected Some, got ok=%v err=%v", ok, err)
+		}
+		if !reflect.DeepEqual(values, []int{1, 2, 3}) {
+			t.Errorf("expected [1 2 3], got %v", values)
+		}
+	})
+
+	t.Run("returns Some with empty slice when there are no rows", func(t *testing.T) {
+		db, _ := openFake(t)
+
+		values, ok, err := sqlfp.QueryAll(ctx, db, "SELECT", scanInt).Get()
+		if err != nil || !ok {
+			t.Fatalf("expected Some, got ok=%v err=%v", ok, err)
+		}
+		if values == nil || len(values) != 0 {
+			t.Errorf("expected empty non-nil slice, got %#v", values)
+		}
+	})
+
+	t.Run("returns Failure when query fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.queryErr = errors.New("query failed")
+
+		_, _, err := sqlfp.QueryAll(ctx, db, "SELECT", scanInt).Get()
+		if !errors.Is(err, state.queryErr) {
+			t.Errorf("expected query error, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure when a scan fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1, 2)
+		scanErr := errors.New("bad column")
+		calls := 0
+		scan := func(s sqlfp.Scanner) (int, error) {
+			calls++
+			if calls == 2 {
+				return 0, scanErr
+			}
+			return scanInt(s)
+		}
+
+		_, _, err := sqlfp.QueryAll(ctx, db, "SELECT", scan).Get()
+		if !errors.Is(err, scanErr) {
+			t.Errorf("expected scan error, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure when row iteration fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1)
+		state.rowsErr = errors.New("connection lost")
+
+		_, _, err := sqlfp.QueryAll(ctx, db, "SELECT", scanInt).Get()
+		if !errors.Is(err, state.rowsErr) {
+			t.Errorf("expected rows error, got %v", err)
+		}
+	})
+
+	t.Run("converts scan panic to Failure", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1)
+		scan := func(sqlfp.Scanner) (int, error) { panic("scan panic") }
+
+		if _, ok := sqlfp.QueryAll(ctx, db, "SELECT", scan).(maybe.Failure[[]int]); !ok {
+			t.Fatal("expected Failure when scan panics")
+		}
+	})
+}
+
+func TestQueryStream(t *testing.T) {
+	ctx := context.Background()
+
+	collect := func(db *sql.DB, scan func(sqlfp.Scanner) (int, error)) []maybe.Maybe[int] {
+		var out []maybe.Maybe[int]
+		for m := range sqlfp.QueryStream(ctx, db, "SELECT", scan) {
+			out = append(out, m)
+		}
+		return out
+	}
+
+	t.Run("yields every row as Some", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1, 2, 3)
+
+		out := collect(db, scanInt)
+		if len(out) != 3 {
+			t.Fatalf("expected 3 elements, got %d", len(out))
+		}
+		for i, m := range out {
+			if v, _, _ := m.Get(); v != i+1 {
+				t.Errorf("element %d: expected %d, got %d", i, i+1, v)
+			}
+		}
+	})
+
+	t.Run("is lazy until iterated", func(t *testing.T) {
+		db, state := openFake(t)
+		state.queryErr = errors.New("query failed")
+
+		_ = sqlfp.QueryStream(ctx, db, "SELECT", scanInt)
+		if state.closedRows != 0 {
+			t.Error("query should not run before iteration")
+		}
+	})
+
+	t.Run("yields a single Failure when query fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.queryErr = errors.New("query failed")
+
+		out := collect(db, scanInt)
+		if len(out) != 1 {
+			t.Fatalf("expected 1 element, got %d", len(out))
+		}
+		if _, _, err := out[0].Get(); !errors.Is(err, state.queryErr) {
+			t.Errorf("expected query error, got %v", err)
+		}
+	})
+
+	t.Run("stops after a scan Failure", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1, 2, 3)
+		scanErr := errors.New("bad column")
+		calls := 0
+		scan := func(s sqlfp.Scanner) (int, error) {
+			calls++
+			if calls == 2 {
+				return 0, scanErr
+			}
+			return scanInt(s)
+		}
+
+		out := collect(db, scan)
+		if len(out) != 2 {
+			t.Fatalf("expected 2 elements, got %d", len(out))
+		}
+		if _, _, err := out[1].Get(); !errors.Is(err, scanErr) {
+			t.Errorf("expected scan error, got %v", err)
+		}
+	})
+
+	t.Run("yields Failure when row iteration fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1)
+		state.rowsErr = errors.New("connection lost")
+
+		out := collect(db, scanInt)
+		if len(out) != 2 {
+			t.Fatalf("expected 2 elements, got %d", len(out))
+		}
+		if _, _, err := out[1].Get(); !errors.Is(err, state.rowsErr) {
+			t.Errorf("expected rows error, got %v", err)
+		}
+	})
+
+	t.Run("closes rows when consumer stops early", func(t *testing.T) {
+		db, state := openFake(t)
+		state.rows = intRows(1, 2, 3)
+
+		for range sqlfp.QueryStream(ctx, db, "SELECT", scanInt) {
+			break
+		}
+		if state.closedRows != 1 {
+			t.Errorf("expected rows to be closed once, got %d", state.closedRows)
+		}
+	})
+}
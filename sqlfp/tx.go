@@ -0,0 +1,52 @@
+package sqlfp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// WithTx runs fn inside a database transaction and returns its result as a Maybe.
+// The transaction is committed when fn succeeds and rolled back otherwise,
+// so callers get correct transaction semantics by construction.
+//
+// Behavior:
+//   - If BeginTx fails: returns Failure with that error (fn not called)
+//   - If fn returns (value, nil): commits and returns Just(value), or Failure if the commit fails
+//   - If fn returns an error: rolls back and returns Failure with the error
+//   - If fn panics: rolls back and returns Failure with the panic converted to an error
+//   - If the rollback itself fails, its error is joined with the original error
+//
+// Example:
+//
+//	user := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (User, error) {
+//	    res, err := tx.ExecContext(ctx, "INSERT INTO users (name) VALUES (?)", name)
+//	    if err != nil {
+//	        return User{}, err
+//	    }
+//	    id, err := res.LastInsertId()
+//	    return User{ID: id, Name: name}, err
+//	}) // Just(user) after commit, or Failed[User](err) after rollback
+func WithTx[T any](ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) (T, error)) maybe.Maybe[T] {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return maybe.Failed[T](err)
+	}
+
+	v, err := maybe.Try(func() (T, error) {
+		return fn(tx)
+	}).OrError()
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			err = errors.Join(err, rbErr)
+		}
+		return maybe.Failed[T](err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return maybe.Failed[T](err)
+	}
+	return maybe.Just(v)
+}
@@ -0,0 +1,111 @@
+package sqlfp_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/sqlfp"
+)
+
+func TestWithTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commits and returns Some on success", func(t *testing.T) {
+		db, state := openFake(t)
+		result := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (int, error) {
+			_, err := tx.ExecContext(ctx, "INSERT")
+			return 42, err
+		})
+
+		value, ok, err := result.Get()
+		if err != nil || !ok || value != 42 {
+			t.Fatalf("expected Just(42), got %v, %v, %v", value, ok, err)
+		}
+		if state.commits != 1 || state.rollbacks != 0 {
+			t.Errorf("expected 1 commit and 0 rollbacks, got %d and %d", state.commits, state.rollbacks)
+		}
+	})
+
+	t.Run("rolls back and returns Failure on error", func(t *testing.T) {
+		db, state := openFake(t)
+		fnErr := errors.New("insert failed")
+		result := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (int, error) {
+			return 0, fnErr
+		})
+
+		_, _, err := result.Get()
+		if !errors.Is(err, fnErr) {
+			t.Fatalf("expected %v, got %v", fnErr, err)
+		}
+		if state.commits != 0 || state.rollbacks != 1 {
+			t.Errorf("expected 0 commits and 1 rollback, got %d and %d", state.commits, state.rollbacks)
+		}
+	})
+
+	t.Run("rolls back and returns Failure on panic", func(t *testing.T) {
+		db, state := openFake(t)
+		result := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (int, error) {
+			panic("something went wrong")
+		})
+
+		failure, ok := result.(maybe.Failure[int])
+		if !ok {
+			t.Fatal("expected Failure when fn panics")
+		}
+		_, _, err := failure.Get()
+		if err.Error() != "something went wrong" {
+			t.Errorf("expected panic message, got %v", err)
+		}
+		if state.rollbacks != 1 {
+			t.Errorf("expected 1 rollback, got %d", state.rollbacks)
+		}
+	})
+
+	t.Run("joins rollback error with original error", func(t *testing.T) {
+		db, state := openFake(t)
+		fnErr := errors.New("insert failed")
+		state.rollbackErr = errors.New("rollback failed")
+		result := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (int, error) {
+			return 0, fnErr
+		})
+
+		_, _, err := result.Get()
+		if !errors.Is(err, fnErr) || !errors.Is(err, state.rollbackErr) {
+			t.Errorf("expected both errors, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure when commit fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.commitErr = errors.New("commit failed")
+		result := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (int, error) {
+			return 42, nil
+		})
+
+		_, _, err := result.Get()
+		if !errors.Is(err, state.commitErr) {
+			t.Errorf("expected commit error, got %v", err)
+		}
+	})
+
+	t.Run("returns Failure without calling fn when begin fails", func(t *testing.T) {
+		db, state := openFake(t)
+		state.beginErr = errors.New("begin failed")
+		called := false
+		result := sqlfp.WithTx(ctx, db, func(tx *sql.Tx) (int, error) {
+			called = true
+			return 42, nil
+		})
+
+		_, _, err := result.Get()
+		if !errors.Is(err, state.beginErr) {
+			t.Errorf("expected begin error, got %v", err)
+		}
+		if called {
+			t.Error("fn should not be called when begin fails")
+		}
+	})
+}
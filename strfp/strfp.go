@@ -0,0 +1,70 @@
+package strfp
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+)
+
+// NonEmpty wraps s in Some unless it is the empty string.
+//
+// Behavior:
+//   - If s is "": returns None
+//   - Otherwise: returns Just(s)
+//
+// Example:
+//
+//	name := strfp.NonEmpty(req.Name).OrElseDefault("anonymous")
+func NonEmpty(s string) maybe.Maybe[string] {
+	if s == "" {
+		return maybe.Empty[string]()
+	}
+	return maybe.Just(s)
+}
+
+// TrimToMaybe trims leading and trailing white space and wraps the rest with NonEmpty,
+// so blank input becomes None.
+//
+// Example:
+//
+//	strfp.TrimToMaybe("  bob ") // Just("bob")
+//	strfp.TrimToMaybe("   ")    // None
+func TrimToMaybe(s string) maybe.Maybe[string] {
+	return NonEmpty(strings.TrimSpace(s))
+}
+
+// CutMaybe slices s around the first instance of sep, like strings.Cut.
+//
+// Behavior:
+//   - If sep is found: returns Just(maybe.Tuple2{First: before, Second: after})
+//   - If sep is not found: returns None
+//
+// Example:
+//
+//	kv := strfp.CutMaybe("user=bob", "=") // Just(Tuple2{"user", "bob"})
+//	strfp.CutMaybe("nokey", "=")          // None
+func CutMaybe(s, sep string) maybe.Maybe[maybe.Tuple2[string, string]] {
+	before, after, found := strings.Cut(s, sep)
+	if !found {
+		return maybe.Empty[maybe.Tuple2[string, string]]()
+	}
+	return maybe.Just(maybe.Tuple2[string, string]{First: before, Second: after})
+}
+
+// AtoiMaybe parses s as a base-10 int.
+//
+// Behavior:
+//   - If s is "": returns None
+//   - If s parses: returns Just(n)
+//   - If s does not parse: returns Failure with the strconv error
+//
+// Example:
+//
+//	page := strfp.AtoiMaybe(query.Get("page")).OrElseDefault(1)
+func AtoiMaybe(s string) maybe.Maybe[int] {
+	if s == "" {
+		return maybe.Empty[int]()
+	}
+	return maybe.ToMaybe(strconv.Atoi(s))
+}
@@ -0,0 +1,87 @@
+package strfp_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/lonelywolflee/lw-project-fp-go/maybe"
+	"github.com/lonelywolflee/lw-project-fp-go/strfp"
+)
+
+func TestNonEmpty(t *testing.T) {
+	t.Run("returns Some for non-empty string", func(t *testing.T) {
+		if v, ok, _ := strfp.NonEmpty("go").Get(); !ok || v != "go" {
+			t.Errorf("expected Just(go), got %q", v)
+		}
+	})
+
+	t.Run("returns None for empty string", func(t *testing.T) {
+		if _, ok := strfp.NonEmpty("").(maybe.None[string]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("keeps white space", func(t *testing.T) {
+		if v, _, _ := strfp.NonEmpty(" ").Get(); v != " " {
+			t.Errorf("expected Just(\" \"), got %q", v)
+		}
+	})
+}
+
+func TestTrimToMaybe(t *testing.T) {
+	t.Run("trims surrounding white space", func(t *testing.T) {
+		if v, ok, _ := strfp.TrimToMaybe("\t bob \n").Get(); !ok || v != "bob" {
+			t.Errorf("expected Just(bob), got %q", v)
+		}
+	})
+
+	t.Run("returns None for blank string", func(t *testing.T) {
+		if _, ok := strfp.TrimToMaybe("  \t").(maybe.None[string]); !ok {
+			t.Error("expected None")
+		}
+	})
+}
+
+func TestCutMaybe(t *testing.T) {
+	t.Run("splits around first separator", func(t *testing.T) {
+		v, ok, _ := strfp.CutMaybe("a=b=c", "=").Get()
+		if !ok || v.First != "a" || v.Second != "b=c" {
+			t.Errorf("expected {a b=c}, got %+v", v)
+		}
+	})
+
+	t.Run("returns None when separator is missing", func(t *testing.T) {
+		if _, ok := strfp.CutMaybe("abc", "=").(maybe.None[maybe.Tuple2[string, string]]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("keeps empty halves", func(t *testing.T) {
+		v, ok, _ := strfp.CutMaybe("=", "=").Get()
+		if !ok || v.First != "" || v.Second != "" {
+			t.Errorf("expected two empty halves, got %+v", v)
+		}
+	})
+}
+
+func TestAtoiMaybe(t *testing.T) {
+	t.Run("parses integers", func(t *testing.T) {
+		if v, ok, _ := strfp.AtoiMaybe("-42").Get(); !ok || v != -42 {
+			t.Errorf("expected -42, got %d", v)
+		}
+	})
+
+	t.Run("returns None for empty string", func(t *testing.T) {
+		if _, ok := strfp.AtoiMaybe("").(maybe.None[int]); !ok {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("returns Failure for invalid input", func(t *testing.T) {
+		_, _, err := strfp.AtoiMaybe("4x2").Get()
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("expected strconv.ErrSyntax, got %v", err)
+		}
+	})
+}